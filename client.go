@@ -1,9 +1,14 @@
 package nollywood
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
 	"github.com/NOLLYWOOD-COM/go-sdk/pkg/catalogue"
 	"github.com/NOLLYWOOD-COM/go-sdk/pkg/config"
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/iam"
 )
 
 // Client provides access to all Nollywood SDK services
@@ -14,6 +19,16 @@ type Client interface {
 	People() catalogue.PeopleService
 	// Articles returns the article service for catalogue operations
 	Articles() catalogue.ArticleService
+	// IAM returns the IAM service for account, API key and permission operations
+	IAM() iam.IAMService
+	// Session authenticates if needed and returns the decoded claims of the current access
+	// and refresh tokens, e.g. to check scopes before calling write endpoints
+	Session(ctx context.Context) (*auth.Session, error)
+	// Logout revokes the refresh token and discards the session, in memory and in the
+	// token store; later requests authenticate again
+	Logout(ctx context.Context) error
+	// Close stops background work such as proactive token refresh
+	Close() error
 }
 
 // client is the concrete implementation of Client
@@ -21,20 +36,51 @@ type NollywoodSDKClient struct {
 	works      catalogue.WorkService
 	people     catalogue.PeopleService
 	articles   catalogue.ArticleService
+	iam        iam.IAMService
 	httpClient httpclient.Client
 }
 
+// NewValidatedClient validates the configuration and creates a new SDK client with it.
+// Unlike NewClient it reports every configuration problem instead of building a client
+// that fails on its first request.
+func NewValidatedClient(config *config.Config) (Client, error) {
+	if config == nil {
+		return nil, fmt.Errorf("invalid config: config is nil")
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return NewClient(config), nil
+}
+
 // NewClient creates a new SDK client with the given configuration
 func NewClient(config *config.Config) Client {
 	// Convert public Config to internal httpclient.Config
 	httpClientConfig := &httpclient.Config{
-		IAMBaseURL:       config.IAMBaseURL,
-		CatalogueBaseURL: config.CatalogueBaseURL,
-		ApiKey:           config.ApiKey,
-		Timeout:          config.Timeout,
-		RetryDelay:       config.RetryDelay,
-		MaxRetries:       config.MaxRetries,
-		UserAgent:        config.UserAgent,
+		IAMBaseURL:            config.IAMBaseURL,
+		CatalogueBaseURL:      config.CatalogueBaseURL,
+		CatalogueFallbackURLs: config.CatalogueFallbackURLs,
+		ApiKey:                config.ApiKey,
+		TokenSource:           config.TokenSource,
+		TokenStore:            config.TokenStore,
+		Timeout:               config.Timeout,
+		RetryDelay:            config.RetryDelay,
+		MaxRetries:            config.MaxRetries,
+		MaxRetryAfter:         config.MaxRetryAfter,
+		UserAgent:             config.UserAgent,
+		Backoff:               config.Backoff,
+		RetryPolicy:           config.RetryPolicy,
+		Middleware:            config.Middleware,
+		HTTPClient:            config.HTTPClient,
+		Transport:             config.Transport,
+		Logger:                config.Logger,
+		Tracer:                config.Tracer,
+		Metrics:               config.Metrics,
+
+		TokenRefreshWindow:     config.TokenRefreshWindow,
+		ClockSkew:              config.ClockSkew,
+		BackgroundTokenRefresh: config.BackgroundTokenRefresh,
 	}
 
 	httpClient := httpclient.New(httpClientConfig)
@@ -44,6 +90,7 @@ func NewClient(config *config.Config) Client {
 		works:      catalogue.NewWorkService(httpClient),
 		people:     catalogue.NewPeopleService(httpClient),
 		articles:   catalogue.NewArticleService(httpClient),
+		iam:        iam.NewIAMService(httpClient),
 	}
 }
 
@@ -58,3 +105,19 @@ func (c *NollywoodSDKClient) People() catalogue.PeopleService {
 func (c *NollywoodSDKClient) Articles() catalogue.ArticleService {
 	return c.articles
 }
+
+func (c *NollywoodSDKClient) IAM() iam.IAMService {
+	return c.iam
+}
+
+func (c *NollywoodSDKClient) Session(ctx context.Context) (*auth.Session, error) {
+	return c.httpClient.Session(ctx)
+}
+
+func (c *NollywoodSDKClient) Logout(ctx context.Context) error {
+	return c.httpClient.Logout(ctx)
+}
+
+func (c *NollywoodSDKClient) Close() error {
+	return c.httpClient.Close()
+}
@@ -0,0 +1,58 @@
+package nollywood
+
+import (
+	"context"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+)
+
+// ErrReauthenticationFailed is returned when a request was rejected with 401 and
+// obtaining a new access token to replay it failed
+var ErrReauthenticationFailed = httpclient.ErrReauthenticationFailed
+
+// ErrRetryAfterTooLong is returned when the server asked to retry after longer than
+// Config.MaxRetryAfter; the request is given up instead of waiting
+var ErrRetryAfterTooLong = httpclient.ErrRetryAfterTooLong
+
+// APIError is returned when a Nollywood service responds with a non-2xx status code.
+// Use errors.As to retrieve it from errors returned by the services.
+type APIError = httpclient.APIError
+
+// RequestError is returned when a request could not be completed at the transport level
+type RequestError = httpclient.RequestError
+
+// IsNotFound reports whether err was caused by a 404 response
+func IsNotFound(err error) bool {
+	return httpclient.IsNotFound(err)
+}
+
+// IsUnauthorized reports whether err was caused by a 401 response
+func IsUnauthorized(err error) bool {
+	return httpclient.IsUnauthorized(err)
+}
+
+// IsForbidden reports whether err was caused by a 403 response
+func IsForbidden(err error) bool {
+	return httpclient.IsForbidden(err)
+}
+
+// IsRateLimited reports whether err was caused by a 429 response
+func IsRateLimited(err error) bool {
+	return httpclient.IsRateLimited(err)
+}
+
+// IsRetryable reports whether err was caused by a response the server may accept on a later attempt
+func IsRetryable(err error) bool {
+	return httpclient.IsRetryable(err)
+}
+
+// WithIdempotencyKey returns a copy of ctx carrying the given idempotency key.
+// Create, update and patch calls made with it send this key instead of a generated one.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return httpclient.WithIdempotencyKey(ctx, key)
+}
+
+// IdempotencyKey returns the Idempotency-Key of the write request that produced err, if any
+func IdempotencyKey(err error) string {
+	return httpclient.IdempotencyKey(err)
+}
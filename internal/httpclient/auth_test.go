@@ -0,0 +1,413 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+// authServer is a test IAM and catalogue server issuing JWTs valid for ttl
+type authServer struct {
+	*httptest.Server
+	logins    atomic.Int32
+	refreshes atomic.Int32
+}
+
+// newAuthServer starts an authServer; every non-auth path is served with 200 and an empty object
+func newAuthServer(t *testing.T, ttl time.Duration, loginDelay time.Duration) *authServer {
+	t.Helper()
+
+	s := &authServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/login/key":
+			s.logins.Add(1)
+			time.Sleep(loginDelay)
+		case "/auth/token/refresh":
+			s.refreshes.Add(1)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+
+		access := createTestToken(map[string]interface{}{"exp": time.Now().Add(ttl).Unix(), "typ": "access"})
+		refresh := createTestToken(map[string]interface{}{"exp": time.Now().Add(24 * time.Hour).Unix(), "typ": "refresh"})
+		_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: access, RefreshToken: refresh})
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestClient_ConcurrentAuthenticationSharesOneLogin(t *testing.T) {
+	server := newAuthServer(t, time.Hour, 50*time.Millisecond)
+
+	c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 200)
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- c.Get(context.Background(), server.URL+"/works/1", nil, nil)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if got := server.logins.Load(); got != 1 {
+		t.Errorf("server received %d logins, want 1", got)
+	}
+}
+
+func TestClient_AuthenticationWaiterRespectsOwnContext(t *testing.T) {
+	server := newAuthServer(t, time.Hour, 200*time.Millisecond)
+
+	c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second})
+
+	// The first caller gives up quickly; the acquisition must still complete for the second
+	shortCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var shortErr, longErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		shortErr = c.Get(shortCtx, server.URL+"/works/1", nil, nil)
+	}()
+	go func() {
+		defer wg.Done()
+		time.Sleep(5 * time.Millisecond)
+		longErr = c.Get(context.Background(), server.URL+"/works/1", nil, nil)
+	}()
+	wg.Wait()
+
+	if !errors.Is(shortErr, context.DeadlineExceeded) {
+		t.Errorf("short caller error = %v, want context.DeadlineExceeded", shortErr)
+	}
+	if longErr != nil {
+		t.Errorf("long caller error = %v, want nil", longErr)
+	}
+	if got := server.logins.Load(); got != 1 {
+		t.Errorf("server received %d logins, want 1", got)
+	}
+}
+
+func TestRefreshDeadline(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		issuedAt  time.Time
+		expiresAt time.Time
+		window    time.Duration
+		skew      time.Duration
+		want      time.Time
+	}{
+		{
+			name:      "window and skew applied",
+			issuedAt:  now,
+			expiresAt: now.Add(time.Hour),
+			window:    time.Minute,
+			skew:      10 * time.Second,
+			want:      now.Add(58*time.Minute + 50*time.Second),
+		},
+		{
+			name:      "window capped at half lifetime",
+			issuedAt:  now,
+			expiresAt: now.Add(time.Minute),
+			window:    5 * time.Minute,
+			want:      now.Add(30 * time.Second),
+		},
+		{
+			name:      "skew never capped",
+			issuedAt:  now,
+			expiresAt: now.Add(8 * time.Second),
+			window:    30 * time.Second,
+			skew:      10 * time.Second,
+			want:      now.Add(-6 * time.Second),
+		},
+		{
+			name:      "lifetime measured from issue time",
+			issuedAt:  now.Add(-50 * time.Minute),
+			expiresAt: now.Add(10 * time.Minute),
+			window:    15 * time.Minute,
+			want:      now.Add(-5 * time.Minute),
+		},
+		{
+			name:      "no window",
+			issuedAt:  now,
+			expiresAt: now.Add(time.Hour),
+			want:      now.Add(time.Hour),
+		},
+		{
+			name:      "already expired",
+			issuedAt:  now,
+			expiresAt: now.Add(-time.Minute),
+			window:    time.Minute,
+			want:      now.Add(-time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := refreshDeadline(tt.issuedAt, tt.expiresAt, tt.window, tt.skew); !got.Equal(tt.want) {
+				t.Errorf("refreshDeadline() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_RenewsTokenInsideRefreshWindow(t *testing.T) {
+	server := newAuthServer(t, time.Hour, 0)
+
+	c := New(&Config{
+		IAMBaseURL:         server.URL,
+		ApiKey:             "test-key",
+		Timeout:            time.Second,
+		TokenRefreshWindow: 5 * time.Minute,
+	}).(*client)
+
+	if err := c.Get(context.Background(), server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Move the token inside the refresh window without expiring it
+	c.authMutex.Lock()
+	c.auth.ExpiresAt = time.Now().Add(time.Minute)
+	c.auth.RefreshAt = time.Now().Add(-time.Second)
+	c.authMutex.Unlock()
+
+	if err := c.Get(context.Background(), server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := server.refreshes.Load(); got != 1 {
+		t.Errorf("server received %d refreshes, want 1", got)
+	}
+	if got := server.logins.Load(); got != 1 {
+		t.Errorf("server received %d logins, want 1", got)
+	}
+}
+
+func TestClient_BackgroundTokenRefresh(t *testing.T) {
+	server := newAuthServer(t, 2*time.Second, 0)
+
+	c := New(&Config{
+		IAMBaseURL:             server.URL,
+		ApiKey:                 "test-key",
+		Timeout:                time.Second,
+		TokenRefreshWindow:     time.Minute,
+		BackgroundTokenRefresh: true,
+	})
+
+	if err := c.Get(context.Background(), server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The token lives at most 2s, so it is renewed within about a second
+	deadline := time.Now().Add(3 * time.Second)
+	for server.refreshes.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if got := server.refreshes.Load(); got == 0 {
+		t.Fatal("background loop did not renew the token")
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("second Close() error = %v", err)
+	}
+
+	refreshes := server.refreshes.Load()
+	time.Sleep(1500 * time.Millisecond)
+	if got := server.refreshes.Load(); got != refreshes {
+		t.Errorf("token renewed %d times after Close, want 0", got-refreshes)
+	}
+}
+
+func TestClient_ReauthenticatesOnUnauthorized(t *testing.T) {
+	tests := []struct {
+		name          string
+		rejectAlways  bool
+		failLogin     bool
+		wantErr       bool
+		wantReauthErr bool
+		wantCalls     int32
+	}{
+		{
+			name:      "replays with new token",
+			wantCalls: 2,
+		},
+		{
+			name:         "gives up after one replay",
+			rejectAlways: true,
+			wantErr:      true,
+			wantCalls:    2,
+		},
+		{
+			name:          "re-authentication fails",
+			failLogin:     true,
+			wantErr:       true,
+			wantReauthErr: true,
+			wantCalls:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls, logins atomic.Int32
+			var mu sync.Mutex
+			var revoked string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/auth/login/key", "/auth/token/refresh":
+					if r.URL.Path == "/auth/login/key" && logins.Add(1) > 1 && tt.failLogin {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					if r.URL.Path == "/auth/token/refresh" && tt.failLogin {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					token := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix(), "n": time.Now().UnixNano()})
+					_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: token, RefreshToken: token})
+				default:
+					calls.Add(1)
+					mu.Lock()
+					defer mu.Unlock()
+					auth := r.Header.Get("Authorization")
+					if revoked == "" {
+						// Revoke the first token the server sees
+						revoked = auth
+					}
+					if tt.rejectAlways || auth == revoked {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					_, _ = w.Write([]byte(`{}`))
+				}
+			}))
+			defer server.Close()
+
+			c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second})
+
+			err := c.Get(context.Background(), server.URL+"/works/1", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, ErrReauthenticationFailed); got != tt.wantReauthErr {
+				t.Errorf("errors.Is(err, ErrReauthenticationFailed) = %v, want %v (err: %v)", got, tt.wantReauthErr, err)
+			}
+			if tt.rejectAlways && !IsUnauthorized(err) {
+				t.Errorf("IsUnauthorized(err) = false, want true (err: %v)", err)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("server received %d calls, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_RetriesLoginAfterServerError(t *testing.T) {
+	var logins atomic.Int32
+	keys := make(chan string, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/auth/login/key" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+
+		keys <- r.Header.Get(IdempotencyKeyHeader)
+		if logins.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		token := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix()})
+		_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: token, RefreshToken: token})
+	}))
+	defer server.Close()
+
+	c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second, MaxRetries: 2, RetryDelay: time.Millisecond})
+
+	if err := c.Get(context.Background(), server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := logins.Load(); got != 2 {
+		t.Errorf("server received %d logins, want 2", got)
+	}
+
+	first, second := <-keys, <-keys
+	if first == "" || first != second {
+		t.Errorf("login idempotency keys = %q, %q; want the same non-empty key", first, second)
+	}
+}
+
+func TestClient_BackgroundRefreshBacksOffOnDueToken(t *testing.T) {
+	expired := createTestToken(map[string]interface{}{"exp": time.Now().Add(-time.Minute).Unix()})
+
+	var calls atomic.Int32
+	source := auth.TokenSourceFunc(func(context.Context) (*auth.Token, error) {
+		calls.Add(1)
+		return &auth.Token{AccessToken: expired}, nil
+	})
+
+	server := newAuthServer(t, time.Hour, 0)
+	c := New(&Config{
+		TokenSource:            source,
+		Timeout:                time.Second,
+		BackgroundTokenRefresh: true,
+	})
+	defer c.Close()
+
+	for i := 0; i < 20; i++ {
+		if err := c.Get(context.Background(), server.URL+"/works/1", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("token source called %d times, want 1", got)
+	}
+}
+
+func TestRenewalBackoff(t *testing.T) {
+	tests := []struct {
+		retryDelay time.Duration
+		attempt    int
+		want       time.Duration
+	}{
+		{retryDelay: 0, attempt: 1, want: minRefreshRetryDelay},
+		{retryDelay: 2 * time.Second, attempt: 1, want: 2 * time.Second},
+		{retryDelay: 2 * time.Second, attempt: 3, want: 8 * time.Second},
+		{retryDelay: 2 * time.Second, attempt: 100, want: maxRefreshRetryDelay},
+	}
+
+	for _, tt := range tests {
+		if got := renewalBackoff(tt.retryDelay, tt.attempt); got != tt.want {
+			t.Errorf("renewalBackoff(%v, %d) = %v, want %v", tt.retryDelay, tt.attempt, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package httpclient
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// DefaultMaxRetryAfter is the longest Retry-After the client waits for when MaxRetryAfter is not set
+const DefaultMaxRetryAfter = time.Minute
+
+// BackoffStrategy computes how long to wait before a retry attempt.
+// attempt starts at 1 for the first retry; previous is the delay used before the
+// previous retry (zero for the first retry).
+type BackoffStrategy interface {
+	Delay(attempt int, previous time.Duration) time.Duration
+}
+
+// BackoffFunc adapts an ordinary function to the BackoffStrategy interface
+type BackoffFunc func(attempt int, previous time.Duration) time.Duration
+
+// Delay calls f(attempt, previous)
+func (f BackoffFunc) Delay(attempt int, previous time.Duration) time.Duration {
+	return f(attempt, previous)
+}
+
+// NewLinearBackoff waits base * attempt, capped at max (no cap if max is zero)
+func NewLinearBackoff(base, max time.Duration) BackoffStrategy {
+	return BackoffFunc(func(attempt int, _ time.Duration) time.Duration {
+		return capDelay(base*time.Duration(attempt), max)
+	})
+}
+
+// NewExponentialBackoff waits base * 2^(attempt-1), capped at max (no cap if max is zero)
+func NewExponentialBackoff(base, max time.Duration) BackoffStrategy {
+	return BackoffFunc(func(attempt int, _ time.Duration) time.Duration {
+		return exponentialDelay(base, max, attempt)
+	})
+}
+
+// NewFullJitterBackoff waits a random duration between zero and the exponential delay,
+// capped at max (no cap if max is zero)
+func NewFullJitterBackoff(base, max time.Duration) BackoffStrategy {
+	return BackoffFunc(func(attempt int, _ time.Duration) time.Duration {
+		ceiling := exponentialDelay(base, max, attempt)
+		if ceiling <= 0 {
+			return 0
+		}
+		return rand.N(ceiling + 1)
+	})
+}
+
+// NewDecorrelatedJitterBackoff waits a random duration between base and three times the
+// previous delay, capped at max (no cap if max is zero)
+func NewDecorrelatedJitterBackoff(base, max time.Duration) BackoffStrategy {
+	return BackoffFunc(func(_ int, previous time.Duration) time.Duration {
+		if base <= 0 {
+			return 0
+		}
+		if previous < base {
+			previous = base
+		}
+
+		upper := previous * 3
+		if upper < previous {
+			// Overflow
+			upper = time.Duration(math.MaxInt64)
+		}
+
+		return capDelay(base+rand.N(upper-base+1), max)
+	})
+}
+
+// exponentialDelay returns base * 2^(attempt-1) capped at max, guarding against overflow
+func exponentialDelay(base, max time.Duration, attempt int) time.Duration {
+	if base <= 0 || attempt <= 0 {
+		return 0
+	}
+
+	delay := base
+	for i := 1; i < attempt; i++ {
+		if delay > time.Duration(math.MaxInt64)/2 {
+			delay = time.Duration(math.MaxInt64)
+			break
+		}
+		delay *= 2
+		if max > 0 && delay >= max {
+			break
+		}
+	}
+
+	return capDelay(delay, max)
+}
+
+// capDelay limits delay to max when max is positive
+func capDelay(delay, max time.Duration) time.Duration {
+	if delay < 0 {
+		return 0
+	}
+	if max > 0 && delay > max {
+		return max
+	}
+	return delay
+}
+
+// sleep waits for the given duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,201 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBackoffStrategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy BackoffStrategy
+		attempt  int
+		previous time.Duration
+		min      time.Duration
+		max      time.Duration
+	}{
+		{
+			name:     "linear first retry",
+			strategy: NewLinearBackoff(100*time.Millisecond, 0),
+			attempt:  1,
+			min:      100 * time.Millisecond,
+			max:      100 * time.Millisecond,
+		},
+		{
+			name:     "linear third retry",
+			strategy: NewLinearBackoff(100*time.Millisecond, 0),
+			attempt:  3,
+			min:      300 * time.Millisecond,
+			max:      300 * time.Millisecond,
+		},
+		{
+			name:     "linear capped",
+			strategy: NewLinearBackoff(100*time.Millisecond, 250*time.Millisecond),
+			attempt:  3,
+			min:      250 * time.Millisecond,
+			max:      250 * time.Millisecond,
+		},
+		{
+			name:     "exponential fourth retry",
+			strategy: NewExponentialBackoff(100*time.Millisecond, 0),
+			attempt:  4,
+			min:      800 * time.Millisecond,
+			max:      800 * time.Millisecond,
+		},
+		{
+			name:     "exponential capped",
+			strategy: NewExponentialBackoff(100*time.Millisecond, time.Second),
+			attempt:  20,
+			min:      time.Second,
+			max:      time.Second,
+		},
+		{
+			name:     "exponential does not overflow",
+			strategy: NewExponentialBackoff(time.Second, 0),
+			attempt:  200,
+			min:      time.Second,
+			max:      time.Duration(1<<63 - 1),
+		},
+		{
+			name:     "full jitter within ceiling",
+			strategy: NewFullJitterBackoff(100*time.Millisecond, 0),
+			attempt:  3,
+			min:      0,
+			max:      400 * time.Millisecond,
+		},
+		{
+			name:     "decorrelated jitter first retry",
+			strategy: NewDecorrelatedJitterBackoff(100*time.Millisecond, 0),
+			attempt:  1,
+			min:      100 * time.Millisecond,
+			max:      300 * time.Millisecond,
+		},
+		{
+			name:     "decorrelated jitter grows from previous",
+			strategy: NewDecorrelatedJitterBackoff(100*time.Millisecond, 0),
+			attempt:  2,
+			previous: time.Second,
+			min:      100 * time.Millisecond,
+			max:      3 * time.Second,
+		},
+		{
+			name:     "decorrelated jitter capped",
+			strategy: NewDecorrelatedJitterBackoff(100*time.Millisecond, 500*time.Millisecond),
+			attempt:  2,
+			previous: time.Second,
+			min:      100 * time.Millisecond,
+			max:      500 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				got := tt.strategy.Delay(tt.attempt, tt.previous)
+				if got < tt.min || got > tt.max {
+					t.Fatalf("Delay(%d, %v) = %v, want between %v and %v", tt.attempt, tt.previous, got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteWithRetry_HonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New(&Config{
+		Timeout:    5 * time.Second,
+		MaxRetries: 2,
+		Backoff: BackoffFunc(func(int, time.Duration) time.Duration {
+			return time.Millisecond
+		}),
+	})
+
+	start := time.Now()
+	err := c.(*client).makeRequest(context.Background(), http.MethodGet, server.URL, nil, nil, false, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retry happened after %v, want at least the 1s Retry-After", elapsed)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("server received %d calls, want 2", got)
+	}
+}
+
+func TestExecuteWithRetry_AbortsOnContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := New(&Config{
+		Timeout:    5 * time.Second,
+		MaxRetries: 3,
+		RetryDelay: time.Minute,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.(*client).makeRequest(ctx, http.MethodGet, server.URL, nil, nil, false, "")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if !IsRetryable(err) {
+		t.Errorf("expected last APIError to remain in the chain, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request took %v after context was cancelled", elapsed)
+	}
+}
+
+func TestExecuteWithRetry_GivesUpOnLongRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := New(&Config{
+		Timeout:       5 * time.Second,
+		MaxRetries:    2,
+		MaxRetryAfter: time.Second,
+	})
+
+	start := time.Now()
+	err := c.(*client).makeRequest(context.Background(), http.MethodGet, server.URL, nil, nil, false, "")
+	if !errors.Is(err, ErrRetryAfterTooLong) {
+		t.Fatalf("expected ErrRetryAfterTooLong, got %v", err)
+	}
+	if !IsRetryable(err) {
+		t.Errorf("expected last APIError to remain in the chain, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("request took %v, want it to give up without waiting", elapsed)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("server received %d calls, want 1", got)
+	}
+}
@@ -4,22 +4,93 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
 )
 
 // New creates a new HTTP client with the given configuration
 func New(config *Config) Client {
-	return &client{
-		httpClient: &http.Client{
-			Timeout: config.Timeout,
-		},
-		config: config,
-		auth:   &AuthState{},
+	backoff := config.Backoff
+	if backoff == nil {
+		backoff = NewLinearBackoff(config.RetryDelay, 0)
+	}
+
+	retryPolicy := config.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = DefaultRetryPolicy()
+	}
+
+	tracer := config.Tracer
+	if tracer == nil {
+		tracer = noopTracer{}
+	}
+
+	metrics := config.Metrics
+	if metrics == nil {
+		metrics = noopMetrics{}
+	}
+
+	c := &client{
+		httpClient:  newHTTPClient(config),
+		config:      config,
+		auth:        &AuthState{},
+		backoff:     backoff,
+		retryPolicy: retryPolicy,
+		tracer:      tracer,
+		metrics:     metrics,
+	}
+	c.send = chainMiddleware(c.httpClient.Do, config.Middleware)
+	c.logger = newLogger(config.Logger, c.secrets)
+	c.tokenSource, c.tokenSourceName = newTokenSource(c, config)
+	c.tokenStore = config.TokenStore
+	c.catalogue = newEndpointPool(append([]string{config.CatalogueBaseURL}, config.CatalogueFallbackURLs...)...)
+	c.closeCtx, c.closeFunc = context.WithCancel(context.Background())
+	c.tokenUpdated = make(chan struct{}, 1)
+	c.refreshDone = make(chan struct{})
+
+	// Resume from a token persisted by a previous run
+	c.loadStoredToken(context.Background())
+
+	if config.BackgroundTokenRefresh {
+		go c.refreshLoop()
+	} else {
+		close(c.refreshDone)
+	}
+
+	return c
+}
+
+// newHTTPClient returns the *http.Client used to send requests, based on the caller-supplied
+// client and transport if any. The caller's client is copied rather than modified.
+func newHTTPClient(config *Config) *http.Client {
+	httpClient := &http.Client{
+		Timeout: config.Timeout,
+	}
+
+	if config.HTTPClient != nil {
+		custom := *config.HTTPClient
+		if custom.Timeout == 0 {
+			custom.Timeout = config.Timeout
+		}
+		httpClient = &custom
+	}
+
+	if config.Transport != nil {
+		httpClient.Transport = config.Transport
 	}
+
+	return httpClient
+}
+
+// StartSpan starts a span with the configured tracer
+func (c *client) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	return c.tracer.Start(ctx, name)
 }
 
 func (c *client) GetIAMBaseURL() string {
@@ -27,30 +98,30 @@ func (c *client) GetIAMBaseURL() string {
 }
 
 func (c *client) GetCatalogueBaseURL() string {
-	return c.config.CatalogueBaseURL
+	return c.catalogue.baseURL()
 }
 
 func (c *client) Get(ctx context.Context, urlStr string, params map[string]string, result interface{}) error {
-	return c.makeRequest(ctx, http.MethodGet, urlStr, params, result, true)
+	return c.makeRequest(ctx, http.MethodGet, urlStr, params, result, true, "")
 }
 
 func (c *client) Post(ctx context.Context, urlStr string, body interface{}, result interface{}) error {
-	return c.makeRequest(ctx, http.MethodPost, urlStr, body, result, true)
+	return c.makeRequest(ctx, http.MethodPost, urlStr, body, result, true, resolveIdempotencyKey(ctx))
 }
 
 func (c *client) Put(ctx context.Context, urlStr string, body interface{}, result interface{}) error {
-	return c.makeRequest(ctx, http.MethodPut, urlStr, body, result, true)
+	return c.makeRequest(ctx, http.MethodPut, urlStr, body, result, true, resolveIdempotencyKey(ctx))
 }
 
 func (c *client) Patch(ctx context.Context, urlStr string, body interface{}, result interface{}) error {
-	return c.makeRequest(ctx, http.MethodPatch, urlStr, body, result, true)
+	return c.makeRequest(ctx, http.MethodPatch, urlStr, body, result, true, resolveIdempotencyKey(ctx))
 }
 
 func (c *client) Delete(ctx context.Context, urlStr string, params map[string]string, result interface{}) error {
-	return c.makeRequest(ctx, http.MethodDelete, urlStr, params, result, true)
+	return c.makeRequest(ctx, http.MethodDelete, urlStr, params, result, true, "")
 }
 
-func (c *client) makeRequest(ctx context.Context, method, urlStr string, data interface{}, result interface{}, authenticate bool) error {
+func (c *client) makeRequest(ctx context.Context, method, urlStr string, data interface{}, result interface{}, authenticate bool, idempotencyKey string) error {
 	var bodyBytes []byte
 	var err error
 
@@ -96,84 +167,243 @@ func (c *client) makeRequest(ctx context.Context, method, urlStr string, data in
 	}
 
 	// Authenticate if required
-	if authenticate {
-		if err := c.authenticate(ctx); err != nil {
-			return fmt.Errorf("authentication failed: %w", err)
-		}
+	if !authenticate {
+		return c.executeWithRetry(ctx, method, urlStr, bodyBytes, result, false, idempotencyKey)
+	}
+
+	if err := c.authenticate(ctx); err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Execute request with retry logic
-	return c.executeWithRetry(ctx, method, urlStr, bodyBytes, result, authenticate)
+	usedToken := c.accessToken()
+	err = c.executeWithRetry(ctx, method, urlStr, bodyBytes, result, true, idempotencyKey)
+	if !IsUnauthorized(err) {
+		return err
+	}
+
+	// The token was rejected, e.g. revoked early: re-authenticate and replay the request once
+	c.logger.WarnContext(ctx, "access token rejected, re-authenticating", "method", method, "url", urlStr)
+	c.invalidateToken(usedToken)
+
+	if err := c.authenticate(ctx); err != nil {
+		return fmt.Errorf("%w: %w", ErrReauthenticationFailed, err)
+	}
+
+	return c.executeWithRetry(ctx, method, urlStr, bodyBytes, result, true, idempotencyKey)
 }
 
-func (c *client) executeWithRetry(ctx context.Context, method, urlStr string, bodyBytes []byte, result interface{}, authenticate bool) error {
+func (c *client) executeWithRetry(ctx context.Context, method, urlStr string, bodyBytes []byte, result interface{}, authenticate bool, idempotencyKey string) error {
 	var lastErr error
+	var delay time.Duration
+	var retryAfter time.Duration
+	var failedOver bool
+
+	// Catalogue requests go to the healthiest endpoint and fail over to the others
+	var endpoint int
+	var rest string
+	var pooled bool
+	if isCatalogueRequest(ctx) {
+		endpoint, rest, pooled = c.catalogue.split(urlStr)
+	}
+	if pooled {
+		endpoint = c.catalogue.preferred()
+		urlStr = c.catalogue.urls[endpoint] + rest
+	}
 
 	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
 		if attempt > 0 {
-			// Wait before retrying with exponential backoff
-			time.Sleep(c.config.RetryDelay * time.Duration(attempt))
-		}
+			// Wait before retrying, preferring the server's Retry-After over our own backoff
+			delay = c.backoff.Delay(attempt, delay)
+			if retryAfter > 0 {
+				delay = retryAfter
+			}
+			// Another endpoint can be tried straight away
+			if failedOver {
+				delay = 0
+			}
 
-		// Create fresh request for each attempt
-		var body io.Reader
-		if len(bodyBytes) > 0 {
-			body = bytes.NewReader(bodyBytes)
+			c.metrics.IncRetry(method, endpointFor(ctx, urlStr))
+			c.logger.InfoContext(ctx, "retrying request",
+				"method", method,
+				"url", urlStr,
+				"attempt", attempt+1,
+				"delay", delay,
+				"error", lastErr,
+			)
+
+			if err := sleep(ctx, delay); err != nil {
+				return fmt.Errorf("retry aborted: %w (last error: %w)", err, lastErr)
+			}
 		}
+		retryAfter = 0
+		failedOver = false
 
-		req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
-		if err != nil {
-			return fmt.Errorf("failed to create request: %w", err)
+		req, resp, err := c.sendAttempt(ctx, method, urlStr, bodyBytes, result, authenticate, idempotencyKey, attempt)
+		if req == nil {
+			return err
 		}
 
-		// Set headers
-		if len(bodyBytes) > 0 {
-			req.Header.Set("Content-Type", "application/json")
+		lastErr = err
+		if pooled && resp != nil && !isEndpointFailure(ctx, resp) {
+			c.catalogue.markHealthy(endpoint)
+		}
+		if lastErr == nil {
+			return nil
 		}
-		req.Header.Set("User-Agent", c.config.UserAgent)
 
-		// Add authorization header if authenticated
-		if authenticate {
-			c.authMutex.RLock()
-			if c.auth.AccessToken != "" {
-				req.Header.Set("Authorization", "Bearer "+c.auth.AccessToken)
+		if pooled && isEndpointFailure(ctx, resp) {
+			if next, ok := c.catalogue.failover(endpoint); ok {
+				c.logger.WarnContext(ctx, "catalogue endpoint failed, failing over",
+					"from", c.catalogue.urls[endpoint],
+					"to", c.catalogue.urls[next],
+					"error", lastErr,
+				)
+				endpoint, failedOver = next, true
+				urlStr = c.catalogue.urls[endpoint] + rest
 			}
-			c.authMutex.RUnlock()
 		}
 
-		// Execute request
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			lastErr = fmt.Errorf("request failed: %w", err)
+		if resp == nil {
+			// Don't retry once the caller has given up
+			if ctx.Err() != nil || !c.retryPolicy.ShouldRetry(req, attempt+1, nil, lastErr) {
+				return lastErr
+			}
 			continue
 		}
 
-		// Handle response
-		lastErr = c.handleResponse(resp, result)
-
-		// Close response body immediately
-		resp.Body.Close()
-
-		// Check if we should retry
-		if lastErr == nil {
-			return nil
+		if !c.retryPolicy.ShouldRetry(req, attempt+1, resp, lastErr) {
+			c.logger.DebugContext(ctx, "not retrying request",
+				"method", method,
+				"url", urlStr,
+				"status", resp.StatusCode,
+				"attempt", attempt+1,
+			)
+			return lastErr
 		}
 
-		// Don't retry on client errors (4xx except 429)
-		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
-			return lastErr
+		retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		if limit := c.maxRetryAfter(); retryAfter > limit {
+			c.logger.WarnContext(ctx, "not retrying request, Retry-After exceeds limit",
+				"method", method,
+				"url", urlStr,
+				"retry_after", retryAfter,
+				"limit", limit,
+			)
+			return fmt.Errorf("%w: server asked to wait %v, limit is %v: %w", ErrRetryAfterTooLong, retryAfter, limit, lastErr)
 		}
+	}
 
-		// Retry on server errors (5xx) and rate limiting (429)
-		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
-			continue
+	c.logger.WarnContext(ctx, "max retries exceeded",
+		"method", method,
+		"url", urlStr,
+		"attempts", c.config.MaxRetries+1,
+		"error", lastErr,
+	)
+
+	return fmt.Errorf("max retries exceeded: %w", lastErr)
+}
+
+// maxRetryAfter returns the longest Retry-After the client waits for
+func (c *client) maxRetryAfter() time.Duration {
+	if c.config.MaxRetryAfter > 0 {
+		return c.config.MaxRetryAfter
+	}
+	return DefaultMaxRetryAfter
+}
+
+// sendAttempt sends a single attempt in its own span and decodes the response into result.
+// req is nil if the request could not be built; resp is nil if it could not be sent.
+// The response body is always consumed and closed.
+func (c *client) sendAttempt(ctx context.Context, method, urlStr string, bodyBytes []byte, result interface{}, authenticate bool, idempotencyKey string, attempt int) (req *http.Request, resp *http.Response, err error) {
+	ctx, span := c.tracer.Start(ctx, "HTTP "+method)
+	defer func() { EndSpan(span, err) }()
+
+	span.SetAttribute("http.request.method", method)
+	span.SetAttribute("url.full", urlStr)
+	span.SetAttribute("http.request.resend_count", attempt)
+
+	// Create fresh request for each attempt
+	var body io.Reader
+	if len(bodyBytes) > 0 {
+		body = bytes.NewReader(bodyBytes)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set headers
+	if len(bodyBytes) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	if idempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+	}
+	if traceParent := span.TraceParent(); traceParent != "" {
+		req.Header.Set(TraceParentHeader, traceParent)
+	}
+
+	// Add authorization header if authenticated
+	if authenticate {
+		c.authMutex.RLock()
+		if c.auth.AccessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+c.auth.AccessToken)
 		}
+		c.authMutex.RUnlock()
+	}
 
-		// For other errors, don't retry
-		return lastErr
+	// Execute request through the middleware chain
+	endpoint := endpointFor(ctx, urlStr)
+	c.metrics.AddInFlight(1)
+	start := time.Now()
+	resp, err = c.send(req)
+	latency := time.Since(start)
+	c.metrics.AddInFlight(-1)
+	if resp == nil && err == nil {
+		// A short-circuiting middleware must return one or the other, as with http.RoundTripper
+		err = errNilResponse
+	}
+	if resp != nil && resp.Body == nil {
+		resp.Body = http.NoBody
+	}
+	if err != nil {
+		c.metrics.ObserveRequest(method, endpoint, 0, latency)
+		c.logger.WarnContext(ctx, "http request failed",
+			"method", method,
+			"url", urlStr,
+			"attempt", attempt+1,
+			"latency", latency,
+			"error", err,
+		)
+		return req, nil, &RequestError{Method: method, URL: urlStr, IdempotencyKey: idempotencyKey, Err: err}
 	}
 
-	return fmt.Errorf("max retries exceeded: %w", lastErr)
+	span.SetAttribute("http.response.status_code", resp.StatusCode)
+	c.metrics.ObserveRequest(method, endpoint, resp.StatusCode, latency)
+
+	// Handle response
+	err = c.handleResponse(resp, result)
+
+	// Close response body immediately
+	resp.Body.Close()
+
+	c.logger.DebugContext(ctx, "http request",
+		"method", method,
+		"url", urlStr,
+		"status", resp.StatusCode,
+		"attempt", attempt+1,
+		"latency", latency,
+	)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		apiErr.IdempotencyKey = idempotencyKey
+	}
+
+	return req, resp, err
 }
 
 func (c *client) handleResponse(resp *http.Response, result interface{}) error {
@@ -194,78 +424,159 @@ func (c *client) handleResponse(resp *http.Response, result interface{}) error {
 		return nil
 	}
 
-	// Error response - include body in error message
-	if len(body) > 0 {
-		return fmt.Errorf("HTTP %d: %s - %s", resp.StatusCode, resp.Status, string(body))
-	}
-	return fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	// Error response - surface as a typed APIError
+	return newAPIError(resp, body)
 }
 
 func (c *client) authenticate(ctx context.Context) error {
-	if c.config.ApiKey == "" {
-		return fmt.Errorf("no API key provided for authentication")
+	if c.tokenSource == nil && c.tokenStore == nil {
+		return errNoCredentials
 	}
 
-	c.authMutex.RLock()
-	hasValidToken := c.auth.AccessToken != "" && time.Now().Before(c.auth.ExpiresAt)
-	hasValidRefresh := c.auth.RefreshToken != "" && time.Now().Before(c.auth.RefreshExpiresAt)
-	c.authMutex.RUnlock()
-
 	// If we have a valid access token, we're good
-	if hasValidToken {
+	if c.hasValidToken() {
 		return nil
 	}
 
-	// Try to refresh token if we have a valid refresh token
-	if hasValidRefresh {
-		if err := c.refreshToken(ctx); err == nil {
-			return nil
-		}
-		// If refresh fails, fall through to get new token
+	return c.acquireTokenShared(ctx)
+}
+
+// hasValidToken reports whether the current access token can be used
+func (c *client) hasValidToken() bool {
+	c.authMutex.RLock()
+	defer c.authMutex.RUnlock()
+
+	// A zero RefreshAt means the token carries no expiry and is used until rejected
+	return c.auth.AccessToken != "" && (c.auth.RefreshAt.IsZero() || time.Now().Before(c.auth.RefreshAt))
+}
+
+// accessToken returns the current access token
+func (c *client) accessToken() string {
+	c.authMutex.RLock()
+	defer c.authMutex.RUnlock()
+
+	return c.auth.AccessToken
+}
+
+// invalidateToken discards the access token if it is still the one that was rejected,
+// so a token obtained concurrently by another request is kept. The refresh token is kept
+// so re-authentication tries a refresh before logging in again.
+func (c *client) invalidateToken(rejected string) {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+
+	if c.auth.AccessToken != rejected {
+		return
 	}
 
-	// Get new token using API key
-	return c.getToken(ctx)
+	c.auth.AccessToken = ""
+	c.auth.ExpiresAt = time.Time{}
+	c.auth.RefreshAt = time.Time{}
+	c.rejectedToken = rejected
 }
 
-func (c *client) getToken(ctx context.Context) error {
-	urlStr := fmt.Sprintf("%s/auth/login/key", c.config.IAMBaseURL)
-	payload := map[string]string{
-		"key": c.config.ApiKey,
+// acquireTokenShared deduplicates concurrent token acquisitions: at most one is in flight,
+// and every caller waiting on it receives its result. Each caller stops waiting when its
+// own context is done, without cancelling the acquisition for the others.
+func (c *client) acquireTokenShared(ctx context.Context) error {
+	c.tokenMutex.Lock()
+	call := c.tokenCall
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		c.tokenCall = call
+
+		// Detach from the first caller's cancellation but keep its values (e.g. trace spans)
+		leaderCtx := context.WithoutCancel(ctx)
+		go func() {
+			// Another acquisition may have completed while this one was being scheduled
+			if !c.hasValidToken() {
+				call.err = c.acquireToken(leaderCtx)
+			}
+
+			c.tokenMutex.Lock()
+			c.tokenCall = nil
+			c.tokenMutex.Unlock()
+
+			close(call.done)
+		}()
 	}
+	c.tokenMutex.Unlock()
 
-	var token TokenPair
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// acquireToken obtains a new access token, refreshing it when possible and
+// falling back to the token source (by default, logging in with the API key)
+func (c *client) acquireToken(ctx context.Context) (err error) {
+	ctx, span := c.tracer.Start(ctx, "Auth.AcquireToken")
+	defer func() { EndSpan(span, err) }()
 
-	// Make unauthenticated request to avoid infinite recursion
-	err := c.makeRequest(ctx, http.MethodPost, urlStr, payload, &token, false)
+	unlock, err := c.lockTokenStore(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get token: %w", err)
+		return err
 	}
+	defer unlock()
 
-	// Parse expiration times from tokens
-	accessExpiry, err := GetTokenExpiration(token.AccessToken)
-	if err != nil {
-		// If we can't parse expiration, set a reasonable default (1 hour)
-		accessExpiry = time.Now().Add(1 * time.Hour)
+	// Another client sharing the store may have obtained a token in the meantime
+	if c.loadStoredToken(ctx) && c.hasValidToken() {
+		span.SetAttribute("nollywood.auth.method", "store")
+		c.logger.DebugContext(ctx, "using access token from token store")
+		return nil
+	}
+
+	c.authMutex.RLock()
+	canRefresh := c.auth.RefreshToken != "" && c.config.IAMBaseURL != "" &&
+		(c.auth.RefreshExpiresAt.IsZero() || time.Now().Add(c.config.ClockSkew).Before(c.auth.RefreshExpiresAt))
+	c.authMutex.RUnlock()
+
+	// Try to refresh token if we have a valid refresh token
+	if canRefresh {
+		err := c.refreshToken(ctx)
+		c.metrics.IncTokenRefresh("refresh", err == nil)
+		if err == nil {
+			span.SetAttribute("nollywood.auth.method", "refresh")
+			c.logger.DebugContext(ctx, "refreshed access token")
+			return nil
+		}
+		// If refresh fails, fall through to get new token
+		c.logger.WarnContext(ctx, "token refresh failed, falling back to token source", "source", c.tokenSourceName, "error", err)
+	}
+
+	if c.tokenSource == nil {
+		return errNoCredentials
 	}
 
-	refreshExpiry, err := GetTokenExpiration(token.RefreshToken)
+	// Get new token from the token source
+	span.SetAttribute("nollywood.auth.method", c.tokenSourceName)
+	token, err := c.tokenSource.Token(ctx)
+	if err == nil && (token == nil || token.AccessToken == "") {
+		err = fmt.Errorf("token source returned no access token")
+	}
+	c.metrics.IncTokenRefresh(c.tokenSourceName, err == nil)
 	if err != nil {
-		// If we can't parse expiration, set a reasonable default (7 days)
-		refreshExpiry = time.Now().Add(7 * 24 * time.Hour)
+		c.logger.ErrorContext(ctx, "failed to obtain access token", "source", c.tokenSourceName, "error", err)
+		return err
 	}
 
-	// Update auth state with mutex protection
-	c.authMutex.Lock()
-	c.auth.AccessToken = token.AccessToken
-	c.auth.RefreshToken = token.RefreshToken
-	c.auth.ExpiresAt = accessExpiry
-	c.auth.RefreshExpiresAt = refreshExpiry
-	c.authMutex.Unlock()
+	c.setToken(ctx, token)
 
+	c.logger.DebugContext(ctx, "obtained access token", "source", c.tokenSourceName)
 	return nil
 }
 
+// secrets returns the credentials that must never appear in log output
+func (c *client) secrets() []string {
+	c.authMutex.RLock()
+	defer c.authMutex.RUnlock()
+
+	return []string{c.config.ApiKey, c.auth.AccessToken, c.auth.RefreshToken}
+}
+
 func (c *client) refreshToken(ctx context.Context) error {
 	urlStr := fmt.Sprintf("%s/auth/token/refresh", c.config.IAMBaseURL)
 
@@ -279,32 +590,86 @@ func (c *client) refreshToken(ctx context.Context) error {
 
 	var token TokenPair
 
-	// Make unauthenticated request to avoid infinite recursion
-	err := c.makeRequest(ctx, http.MethodPost, urlStr, payload, &token, false)
+	// Make unauthenticated request to avoid infinite recursion. Its own idempotency key,
+	// never the caller's, lets the retry policy retry this POST after 5xx and transport errors.
+	err := c.makeRequest(WithEndpoint(ctx, "/auth/token/refresh"), http.MethodPost, urlStr, payload, &token, false, newIdempotencyKey())
 	if err != nil {
 		return fmt.Errorf("failed to refresh token: %w", err)
 	}
 
-	// Parse expiration times from tokens
-	accessExpiry, err := GetTokenExpiration(token.AccessToken)
-	if err != nil {
-		// If we can't parse expiration, set a reasonable default (1 hour)
-		accessExpiry = time.Now().Add(1 * time.Hour)
+	c.setToken(ctx, tokenFromPair(token))
+
+	return nil
+}
+
+// setToken stores a new token, schedules its renewal and persists it to the token store
+func (c *client) setToken(ctx context.Context, token *auth.Token) {
+	c.saveToken(ctx, c.applyToken(ctx, token, true))
+}
+
+// applyToken makes token the current token and schedules its renewal. Missing expiry times
+// are read from the tokens when they are JWTs. It returns the token with expiries filled in.
+// renewed is true for tokens just obtained, rather than loaded from the token store.
+func (c *client) applyToken(ctx context.Context, token *auth.Token, renewed bool) *auth.Token {
+	now := time.Now()
+
+	accessExpiry := token.ExpiresAt
+	if accessExpiry.IsZero() {
+		if exp, err := GetTokenExpiration(token.AccessToken); err == nil {
+			accessExpiry = exp
+		}
 	}
 
-	refreshExpiry, err := GetTokenExpiration(token.RefreshToken)
-	if err != nil {
-		// If we can't parse expiration, set a reasonable default (7 days)
-		refreshExpiry = time.Now().Add(7 * 24 * time.Hour)
+	refreshExpiry := token.RefreshExpiresAt
+	if refreshExpiry.IsZero() && token.RefreshToken != "" {
+		if exp, err := GetTokenExpiration(token.RefreshToken); err == nil {
+			refreshExpiry = exp
+		}
+	}
+
+	// Tokens without an expiry are never renewed proactively
+	var refreshAt time.Time
+	if !accessExpiry.IsZero() {
+		// A stored token was issued some time ago: measure its lifetime from its "iat" claim,
+		// not from now, so the time left is not mistaken for its full lifetime
+		issuedAt := now
+		if claims, err := auth.ParseClaims(token.AccessToken); err == nil && !renewed && !claims.IssuedAt.IsZero() {
+			issuedAt = claims.IssuedAt
+		}
+		refreshAt = refreshDeadline(issuedAt, accessExpiry, c.config.TokenRefreshWindow, c.config.ClockSkew)
 	}
 
 	// Update auth state with mutex protection
 	c.authMutex.Lock()
+	// A new token that is already due for renewal, e.g. an expired static token, counts as a
+	// failed renewal: it is used as is and renewal backs off instead of running on every request
+	if renewed && !refreshAt.IsZero() && refreshAt.Before(now.Add(minRefreshRetryDelay)) {
+		c.dueRenewals++
+		refreshAt = now.Add(renewalBackoff(c.config.RetryDelay, c.dueRenewals))
+	} else if renewed {
+		c.dueRenewals = 0
+	}
+	dueRenewals := c.dueRenewals
 	c.auth.AccessToken = token.AccessToken
 	c.auth.RefreshToken = token.RefreshToken
 	c.auth.ExpiresAt = accessExpiry
 	c.auth.RefreshExpiresAt = refreshExpiry
+	c.auth.RefreshAt = refreshAt
 	c.authMutex.Unlock()
 
-	return nil
+	if dueRenewals > 0 {
+		c.logger.WarnContext(ctx, "new access token is already due for renewal, backing off",
+			"expires_at", accessExpiry,
+			"retry_at", refreshAt,
+		)
+	}
+
+	c.notifyTokenUpdated()
+
+	return &auth.Token{
+		AccessToken:      token.AccessToken,
+		RefreshToken:     token.RefreshToken,
+		ExpiresAt:        accessExpiry,
+		RefreshExpiresAt: refreshExpiry,
+	}
 }
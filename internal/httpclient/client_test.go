@@ -0,0 +1,89 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	transport := &http.Transport{}
+
+	tests := []struct {
+		name          string
+		config        *Config
+		wantTimeout   time.Duration
+		wantTransport http.RoundTripper
+	}{
+		{
+			name:        "default client",
+			config:      &Config{Timeout: 5 * time.Second},
+			wantTimeout: 5 * time.Second,
+		},
+		{
+			name:        "custom client keeps its timeout",
+			config:      &Config{Timeout: 5 * time.Second, HTTPClient: &http.Client{Timeout: time.Second}},
+			wantTimeout: time.Second,
+		},
+		{
+			name:        "custom client without timeout uses config timeout",
+			config:      &Config{Timeout: 5 * time.Second, HTTPClient: &http.Client{}},
+			wantTimeout: 5 * time.Second,
+		},
+		{
+			name:          "transport overrides client transport",
+			config:        &Config{HTTPClient: &http.Client{Transport: http.DefaultTransport}, Transport: transport},
+			wantTransport: transport,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newHTTPClient(tt.config)
+
+			if got == tt.config.HTTPClient {
+				t.Error("newHTTPClient() returned the caller's client instead of a copy")
+			}
+			if got.Timeout != tt.wantTimeout {
+				t.Errorf("Timeout = %v, want %v", got.Timeout, tt.wantTimeout)
+			}
+			if tt.wantTransport != nil && got.Transport != tt.wantTransport {
+				t.Errorf("Transport = %v, want %v", got.Transport, tt.wantTransport)
+			}
+		})
+	}
+}
+
+type countingTransport struct {
+	calls atomic.Int32
+	next  http.RoundTripper
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls.Add(1)
+	return t.next.RoundTrip(req)
+}
+
+func TestClient_UsesCustomTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "test-agent" {
+			t.Errorf("User-Agent = %q, want %q", r.Header.Get("User-Agent"), "test-agent")
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{next: http.DefaultTransport}
+	c := New(&Config{Timeout: time.Second, UserAgent: "test-agent", Transport: transport})
+
+	if err := c.(*client).makeRequest(context.Background(), http.MethodGet, server.URL, nil, nil, false, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := transport.calls.Load(); got != 1 {
+		t.Errorf("transport received %d calls, want 1", got)
+	}
+}
@@ -0,0 +1,227 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrReauthenticationFailed is returned when a request was rejected with 401 and
+// obtaining a new access token to replay it failed
+var ErrReauthenticationFailed = errors.New("re-authentication failed")
+
+// ErrRetryAfterTooLong is returned when the server asked to retry after longer than the
+// configured MaxRetryAfter; the client gives up instead of waiting
+var ErrRetryAfterTooLong = errors.New("retry-after exceeds limit")
+
+// APIError is returned when a service responds with a non-2xx status code.
+// It is wrapped with %w by the services, so callers can retrieve it with errors.As.
+type APIError struct {
+	StatusCode     int           // HTTP status code of the response
+	Status         string        // HTTP status line, e.g. "404 Not Found"
+	Code           string        // Machine-readable error code from the response body, if any
+	Message        string        // Human-readable error message from the response body, if any
+	RequestID      string        // Request ID reported by the server, if any
+	RetryAfter     time.Duration // Delay requested by the server via the Retry-After header
+	Body           []byte        // Raw response body
+	IdempotencyKey string        // Idempotency-Key sent with the request, if any
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "HTTP %d", e.StatusCode)
+
+	if e.Code != "" {
+		fmt.Fprintf(&sb, " %s", e.Code)
+	}
+
+	switch {
+	case e.Message != "":
+		fmt.Fprintf(&sb, ": %s", e.Message)
+	case len(e.Body) > 0:
+		fmt.Fprintf(&sb, ": %s - %s", e.Status, string(e.Body))
+	case e.Status != "":
+		fmt.Fprintf(&sb, ": %s", e.Status)
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, " (request id: %s)", e.RequestID)
+	}
+
+	return sb.String()
+}
+
+// RequestError is returned when a request could not be completed at the transport level,
+// e.g. because the connection failed or timed out
+type RequestError struct {
+	Method         string // HTTP method of the request
+	URL            string // URL of the request
+	IdempotencyKey string // Idempotency-Key sent with the request, if any
+	Err            error  // Underlying transport error
+}
+
+// Error implements the error interface
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("request failed: %v", e.Err)
+}
+
+// Unwrap returns the underlying transport error
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// newAPIError builds an APIError from a response and its already-read body
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RequestID:  requestIDFromHeader(resp.Header),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		Body:       body,
+	}
+
+	apiErr.Code, apiErr.Message = parseErrorBody(body)
+
+	return apiErr
+}
+
+// parseErrorBody extracts the error code and message from a JSON error body.
+// Both {"code": "...", "message": "..."} and {"error": {"code": "...", "message": "..."}}
+// shapes are supported, as is a plain string in the "error" field.
+func parseErrorBody(body []byte) (code, message string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+
+	var payload struct {
+		Code    json.RawMessage `json:"code"`
+		Message string          `json:"message"`
+		Error   json.RawMessage `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", ""
+	}
+
+	code = rawToString(payload.Code)
+	message = payload.Message
+
+	if len(payload.Error) > 0 {
+		var nested struct {
+			Code    json.RawMessage `json:"code"`
+			Message string          `json:"message"`
+		}
+
+		if err := json.Unmarshal(payload.Error, &nested); err == nil {
+			if code == "" {
+				code = rawToString(nested.Code)
+			}
+			if message == "" {
+				message = nested.Message
+			}
+		} else if s := rawToString(payload.Error); s != "" {
+			// A plain string in "error" is a code when a message is also present
+			if message == "" {
+				message = s
+			} else if code == "" {
+				code = s
+			}
+		}
+	}
+
+	return code, message
+}
+
+// rawToString converts a raw JSON string or number into a string
+func rawToString(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String()
+	}
+
+	return ""
+}
+
+// requestIDFromHeader returns the request ID from the common request ID headers
+func requestIDFromHeader(header http.Header) string {
+	for _, key := range []string{"X-Request-Id", "X-Correlation-Id", "Request-Id"} {
+		if v := header.Get(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// parseRetryAfter parses a Retry-After header value in either delay-seconds
+// or HTTP-date form. Returns zero if the value is empty, invalid or in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// statusCode returns the status code of the APIError in err's chain, or 0 if there is none
+func statusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an APIError with status 404
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is an APIError with status 401
+func IsUnauthorized(err error) bool {
+	return statusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an APIError with status 403
+func IsForbidden(err error) bool {
+	return statusCode(err) == http.StatusForbidden
+}
+
+// IsRateLimited reports whether err is an APIError with status 429
+func IsRateLimited(err error) bool {
+	return statusCode(err) == http.StatusTooManyRequests
+}
+
+// IsRetryable reports whether err is an APIError the server may accept on a later attempt,
+// i.e. a rate limit (429) or a server error (5xx)
+func IsRetryable(err error) bool {
+	code := statusCode(err)
+	return code == http.StatusTooManyRequests || code >= 500
+}
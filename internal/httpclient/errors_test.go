@@ -0,0 +1,221 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseErrorBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "empty body",
+			body:        "",
+			wantCode:    "",
+			wantMessage: "",
+		},
+		{
+			name:        "not json",
+			body:        "upstream timed out",
+			wantCode:    "",
+			wantMessage: "",
+		},
+		{
+			name:        "flat code and message",
+			body:        `{"code":"WORK_NOT_FOUND","message":"work not found"}`,
+			wantCode:    "WORK_NOT_FOUND",
+			wantMessage: "work not found",
+		},
+		{
+			name:        "numeric code",
+			body:        `{"code":404,"message":"work not found"}`,
+			wantCode:    "404",
+			wantMessage: "work not found",
+		},
+		{
+			name:        "nested error object",
+			body:        `{"error":{"code":"INVALID_KEY","message":"api key is invalid"}}`,
+			wantCode:    "INVALID_KEY",
+			wantMessage: "api key is invalid",
+		},
+		{
+			name:        "error string only",
+			body:        `{"error":"Unauthorized"}`,
+			wantCode:    "",
+			wantMessage: "Unauthorized",
+		},
+		{
+			name:        "error string with message",
+			body:        `{"statusCode":401,"error":"Unauthorized","message":"token expired"}`,
+			wantCode:    "Unauthorized",
+			wantMessage: "token expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, message := parseErrorBody([]byte(tt.body))
+
+			if code != tt.wantCode {
+				t.Errorf("parseErrorBody() code = %q, want %q", code, tt.wantCode)
+			}
+			if message != tt.wantMessage {
+				t.Errorf("parseErrorBody() message = %q, want %q", message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{
+			name:  "empty",
+			value: "",
+			want:  0,
+		},
+		{
+			name:  "seconds",
+			value: "120",
+			want:  120 * time.Second,
+		},
+		{
+			name:  "negative seconds",
+			value: "-5",
+			want:  0,
+		},
+		{
+			name:  "http date in future",
+			value: now.Add(30 * time.Second).Format(http.TimeFormat),
+			want:  30 * time.Second,
+		},
+		{
+			name:  "http date in past",
+			value: now.Add(-30 * time.Second).Format(http.TimeFormat),
+			want:  0,
+		},
+		{
+			name:  "garbage",
+			value: "soon",
+			want:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		name             string
+		err              error
+		wantNotFound     bool
+		wantUnauthorized bool
+		wantRateLimited  bool
+		wantRetryable    bool
+	}{
+		{
+			name:         "not found",
+			err:          &APIError{StatusCode: http.StatusNotFound},
+			wantNotFound: true,
+		},
+		{
+			name:             "unauthorized wrapped",
+			err:              fmt.Errorf("failed to get work: %w", &APIError{StatusCode: http.StatusUnauthorized}),
+			wantUnauthorized: true,
+		},
+		{
+			name:            "rate limited",
+			err:             &APIError{StatusCode: http.StatusTooManyRequests},
+			wantRateLimited: true,
+			wantRetryable:   true,
+		},
+		{
+			name:          "server error",
+			err:           fmt.Errorf("max retries exceeded: %w", &APIError{StatusCode: http.StatusServiceUnavailable}),
+			wantRetryable: true,
+		},
+		{
+			name: "plain error",
+			err:  errors.New("boom"),
+		},
+		{
+			name: "nil error",
+			err:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.wantNotFound)
+			}
+			if got := IsUnauthorized(tt.err); got != tt.wantUnauthorized {
+				t.Errorf("IsUnauthorized() = %v, want %v", got, tt.wantUnauthorized)
+			}
+			if got := IsRateLimited(tt.err); got != tt.wantRateLimited {
+				t.Errorf("IsRateLimited() = %v, want %v", got, tt.wantRateLimited)
+			}
+			if got := IsRetryable(tt.err); got != tt.wantRetryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.wantRetryable)
+			}
+		})
+	}
+}
+
+func TestClient_APIErrorFromResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"WORK_NOT_FOUND","message":"work not found"}`))
+	}))
+	defer server.Close()
+
+	c := New(&Config{CatalogueBaseURL: server.URL, Timeout: time.Second})
+
+	err := c.(*client).makeRequest(context.Background(), http.MethodGet, server.URL+"/works/missing", nil, nil, false, "")
+	if err == nil {
+		t.Fatal("expected error but got none")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError in chain, got %T: %v", err, err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusNotFound)
+	}
+	if apiErr.Code != "WORK_NOT_FOUND" {
+		t.Errorf("Code = %q, want %q", apiErr.Code, "WORK_NOT_FOUND")
+	}
+	if apiErr.Message != "work not found" {
+		t.Errorf("Message = %q, want %q", apiErr.Message, "work not found")
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("RequestID = %q, want %q", apiErr.RequestID, "req-123")
+	}
+	if !IsNotFound(err) {
+		t.Error("IsNotFound() = false, want true")
+	}
+}
@@ -0,0 +1,134 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// endpointCooldown is how long a failed endpoint is avoided before it is tried again
+const endpointCooldown = 30 * time.Second
+
+// catalogueContextKey is the context key marking catalogue requests
+type catalogueContextKey struct{}
+
+// WithCatalogueEndpoint is WithEndpoint for catalogue requests. Only requests marked this way go
+// to the healthiest catalogue endpoint and fail over to the others, so requests to other
+// services sharing a host with the catalogue, such as IAM, are never redirected.
+func WithCatalogueEndpoint(ctx context.Context, template string) context.Context {
+	return context.WithValue(WithEndpoint(ctx, template), catalogueContextKey{}, true)
+}
+
+// isCatalogueRequest reports whether ctx was marked by WithCatalogueEndpoint
+func isCatalogueRequest(ctx context.Context) bool {
+	marked, _ := ctx.Value(catalogueContextKey{}).(bool)
+	return marked
+}
+
+// endpointPool tracks the health of interchangeable base URLs, tried in order.
+// A failed endpoint is skipped until its cooldown expires, so requests keep going to
+// the endpoint that last worked.
+type endpointPool struct {
+	urls []string
+
+	mu        sync.Mutex
+	downUntil []time.Time
+}
+
+// newEndpointPool creates a pool of the non-empty, distinct base URLs
+func newEndpointPool(urls ...string) *endpointPool {
+	p := &endpointPool{}
+	for _, u := range urls {
+		u = strings.TrimSuffix(u, "/")
+		if u == "" || p.index(u) >= 0 {
+			continue
+		}
+		p.urls = append(p.urls, u)
+	}
+	p.downUntil = make([]time.Time, len(p.urls))
+	return p
+}
+
+// index returns the position of base URL u, or -1
+func (p *endpointPool) index(u string) int {
+	for i, candidate := range p.urls {
+		if candidate == u {
+			return i
+		}
+	}
+	return -1
+}
+
+// preferred returns the index of the first endpoint that is not cooling down.
+// When every endpoint has failed recently, the one that recovers first is used.
+func (p *endpointPool) preferred() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	best := 0
+	for i, until := range p.downUntil {
+		if !now.Before(until) {
+			return i
+		}
+		if until.Before(p.downUntil[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// baseURL returns the base URL requests should currently use
+func (p *endpointPool) baseURL() string {
+	if len(p.urls) == 0 {
+		return ""
+	}
+	return p.urls[p.preferred()]
+}
+
+// split returns the endpoint urlStr was built from and the remainder of the URL after it
+func (p *endpointPool) split(urlStr string) (int, string, bool) {
+	for i, base := range p.urls {
+		rest, ok := strings.CutPrefix(urlStr, base)
+		if ok && (rest == "" || rest[0] == '/' || rest[0] == '?') {
+			return i, rest, true
+		}
+	}
+	return 0, "", false
+}
+
+// markHealthy clears any cooldown on endpoint i
+func (p *endpointPool) markHealthy(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.downUntil[i] = time.Time{}
+}
+
+// failover puts endpoint i in cooldown and returns the next endpoint to try, if any is healthy
+func (p *endpointPool) failover(i int) (int, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	p.downUntil[i] = now.Add(endpointCooldown)
+
+	for step := 1; step < len(p.urls); step++ {
+		next := (i + step) % len(p.urls)
+		if !now.Before(p.downUntil[next]) {
+			return next, true
+		}
+	}
+	return i, false
+}
+
+// isEndpointFailure reports whether an attempt failed because of the endpoint itself:
+// a connection error or a 5xx response
+func isEndpointFailure(ctx context.Context, resp *http.Response) bool {
+	if resp == nil {
+		return ctx.Err() == nil
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
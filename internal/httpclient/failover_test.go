@@ -0,0 +1,163 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+// catalogueCtx marks requests as catalogue requests, as the catalogue services do
+var catalogueCtx = WithCatalogueEndpoint(context.Background(), "/works/{identifier}")
+
+// countingServer serves every request with status and counts them
+func countingServer(t *testing.T, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+func TestClient_CatalogueFailover(t *testing.T) {
+	tests := []struct {
+		name    string
+		primary func(t *testing.T) (string, *atomic.Int32)
+	}{
+		{
+			name: "5xx",
+			primary: func(t *testing.T) (string, *atomic.Int32) {
+				server, hits := countingServer(t, http.StatusServiceUnavailable)
+				return server.URL, hits
+			},
+		},
+		{
+			name: "connection error",
+			primary: func(t *testing.T) (string, *atomic.Int32) {
+				server, hits := countingServer(t, http.StatusOK)
+				server.Close()
+				return server.URL, hits
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primaryURL, _ := tt.primary(t)
+			fallback, fallbackHits := countingServer(t, http.StatusOK)
+
+			c := New(&Config{
+				CatalogueBaseURL:      primaryURL,
+				CatalogueFallbackURLs: []string{fallback.URL},
+				TokenSource:           auth.StaticTokenSource("test-token"),
+				Timeout:               time.Second,
+				MaxRetries:            1,
+				RetryDelay:            time.Hour, // failing over must not wait
+			})
+
+			for i := 0; i < 2; i++ {
+				if err := c.Get(catalogueCtx, c.GetCatalogueBaseURL()+"/works/1", nil, nil); err != nil {
+					t.Fatalf("request %d: unexpected error: %v", i, err)
+				}
+			}
+
+			if got := fallbackHits.Load(); got != 2 {
+				t.Errorf("fallback received %d requests, want 2", got)
+			}
+			if got := c.GetCatalogueBaseURL(); got != fallback.URL {
+				t.Errorf("GetCatalogueBaseURL() = %q, want the healthy fallback %q", got, fallback.URL)
+			}
+		})
+	}
+}
+
+func TestClient_CatalogueFailoverRemembersHealthyEndpoint(t *testing.T) {
+	primary, primaryHits := countingServer(t, http.StatusBadGateway)
+	fallback, _ := countingServer(t, http.StatusOK)
+
+	c := New(&Config{
+		CatalogueBaseURL:      primary.URL,
+		CatalogueFallbackURLs: []string{fallback.URL},
+		TokenSource:           auth.StaticTokenSource("test-token"),
+		Timeout:               time.Second,
+		MaxRetries:            1,
+	})
+
+	for i := 0; i < 3; i++ {
+		// URLs built from the configured primary are redirected as well
+		if err := c.Get(catalogueCtx, primary.URL+"/works/1", nil, nil); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+
+	if got := primaryHits.Load(); got != 1 {
+		t.Errorf("failed primary received %d requests, want 1", got)
+	}
+
+	// Once the cooldown expires the primary is tried again
+	pool := c.(*client).catalogue
+	pool.mu.Lock()
+	pool.downUntil[0] = time.Now().Add(-time.Second)
+	pool.mu.Unlock()
+
+	if got := c.GetCatalogueBaseURL(); got != primary.URL {
+		t.Errorf("GetCatalogueBaseURL() after cooldown = %q, want primary %q", got, primary.URL)
+	}
+}
+
+func TestClient_CatalogueFailoverRespectsRetryPolicy(t *testing.T) {
+	primary, _ := countingServer(t, http.StatusInternalServerError)
+	fallback, fallbackHits := countingServer(t, http.StatusOK)
+
+	c := New(&Config{
+		CatalogueBaseURL:      primary.URL,
+		CatalogueFallbackURLs: []string{fallback.URL},
+		TokenSource:           auth.StaticTokenSource("test-token"),
+		Timeout:               time.Second,
+		MaxRetries:            2,
+	})
+
+	// A POST without an idempotency key is not safe to send twice
+	if err := c.(*client).makeRequest(WithCatalogueEndpoint(context.Background(), "/works"), http.MethodPost, primary.URL+"/works", nil, nil, true, ""); err == nil {
+		t.Fatal("expected error but got none")
+	}
+	if got := fallbackHits.Load(); got != 0 {
+		t.Errorf("fallback received %d requests, want 0", got)
+	}
+}
+
+func TestClient_CatalogueFailoverSkipsOtherServices(t *testing.T) {
+	primary, primaryHits := countingServer(t, http.StatusBadGateway)
+	fallback, fallbackHits := countingServer(t, http.StatusOK)
+
+	c := New(&Config{
+		IAMBaseURL:            primary.URL + "/iam",
+		CatalogueBaseURL:      primary.URL,
+		CatalogueFallbackURLs: []string{fallback.URL},
+		ApiKey:                "test-key",
+		Timeout:               time.Second,
+		MaxRetries:            1,
+	})
+
+	// IAM shares the catalogue's host, so its login must fail rather than go to the fallback
+	err := c.Get(WithEndpoint(context.Background(), "/users/me"), primary.URL+"/iam/users/me", nil, nil)
+	if err == nil {
+		t.Fatal("expected error but got none")
+	}
+	if got := fallbackHits.Load(); got != 0 {
+		t.Errorf("fallback received %d requests, want 0", got)
+	}
+	if got := primaryHits.Load(); got != 2 {
+		t.Errorf("primary received %d requests, want the login and its retry", got)
+	}
+}
@@ -0,0 +1,59 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// idempotencyKeyContextKey is the context key for caller-supplied idempotency keys
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx carrying the given idempotency key.
+// Mutating requests made with the returned context send this key instead of a generated one.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key carried by ctx, if any
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// IdempotencyKey returns the idempotency key of the request that produced err, if any
+func IdempotencyKey(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.IdempotencyKey != "" {
+		return apiErr.IdempotencyKey
+	}
+
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.IdempotencyKey
+	}
+
+	return ""
+}
+
+// resolveIdempotencyKey returns the key carried by ctx, or a newly generated one.
+// The key is resolved once per logical call so every retry sends the same value.
+func resolveIdempotencyKey(ctx context.Context) string {
+	if key := IdempotencyKeyFromContext(ctx); key != "" {
+		return key
+	}
+	return newIdempotencyKey()
+}
+
+// newIdempotencyKey generates a random (version 4) UUID
+func newIdempotencyKey() string {
+	var b [16]byte
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(b[:])
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
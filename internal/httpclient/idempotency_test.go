@@ -0,0 +1,82 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewIdempotencyKey(t *testing.T) {
+	uuidPattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		key := newIdempotencyKey()
+		if !uuidPattern.MatchString(key) {
+			t.Fatalf("newIdempotencyKey() = %q, want a version 4 UUID", key)
+		}
+		if seen[key] {
+			t.Fatalf("newIdempotencyKey() returned duplicate key %q", key)
+		}
+		seen[key] = true
+	}
+}
+
+func TestClient_IdempotencyKeyReusedAcrossRetries(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantKey string
+	}{
+		{
+			name: "generated key",
+			ctx:  context.Background(),
+		},
+		{
+			name:    "key from context",
+			ctx:     WithIdempotencyKey(context.Background(), "ingest-42"),
+			wantKey: "ingest-42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var keys []string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+				mu.Unlock()
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			c := New(&Config{Timeout: time.Second, MaxRetries: 2})
+
+			err := c.(*client).makeRequest(tt.ctx, http.MethodPost, server.URL, map[string]string{"a": "b"}, nil, false, resolveIdempotencyKey(tt.ctx))
+			if err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if len(keys) != 3 {
+				t.Fatalf("server received %d calls, want 3", len(keys))
+			}
+			for _, key := range keys {
+				if key == "" || key != keys[0] {
+					t.Fatalf("idempotency keys across retries = %v, want one stable non-empty key", keys)
+				}
+			}
+			if tt.wantKey != "" && keys[0] != tt.wantKey {
+				t.Errorf("idempotency key = %q, want %q", keys[0], tt.wantKey)
+			}
+			if got := IdempotencyKey(err); got != keys[0] {
+				t.Errorf("IdempotencyKey(err) = %q, want %q", got, keys[0])
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package httpclient
 
-import "context"
+import (
+	"context"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
 
 // Client is the internal HTTP client interface for making requests
 type Client interface {
@@ -11,4 +15,8 @@ type Client interface {
 	Patch(ctx context.Context, url string, body interface{}, result interface{}) error
 	Post(ctx context.Context, url string, body interface{}, result interface{}) error
 	Put(ctx context.Context, url string, body interface{}, result interface{}) error
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+	Session(ctx context.Context) (*auth.Session, error)
+	Logout(ctx context.Context) error
+	Close() error
 }
@@ -0,0 +1,134 @@
+package httpclient
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// redacted replaces secret values in log output
+const redacted = "[REDACTED]"
+
+// sensitiveLogKeys are attribute keys whose values are always redacted (compared case-insensitively,
+// ignoring "_" and "-")
+var sensitiveLogKeys = map[string]bool{
+	"apikey":        true,
+	"key":           true,
+	"authorization": true,
+	"token":         true,
+	"accesstoken":   true,
+	"refreshtoken":  true,
+	"bearer":        true,
+	"password":      true,
+	"secret":        true,
+}
+
+// newLogger returns the logger used by the client. Secrets are redacted from everything
+// written through it; a nil logger discards all output.
+func newLogger(logger *slog.Logger, secrets func() []string) *slog.Logger {
+	if logger == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+	return slog.New(&redactingHandler{next: logger.Handler(), secrets: secrets})
+}
+
+// redactingHandler is a slog.Handler that removes credentials before passing records on.
+// Values of sensitive keys are replaced entirely, and any occurrence of a known secret
+// (API key, access token, refresh token) in a message or string value is masked.
+type redactingHandler struct {
+	next    slog.Handler
+	secrets func() []string
+}
+
+// Enabled implements slog.Handler
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler
+func (h *redactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	secrets := h.currentSecrets()
+
+	clean := slog.NewRecord(record.Time, record.Level, maskSecrets(record.Message, secrets), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		clean.AddAttrs(redactAttr(attr, secrets))
+		return true
+	})
+
+	return h.next.Handle(ctx, clean)
+}
+
+// WithAttrs implements slog.Handler
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	secrets := h.currentSecrets()
+
+	clean := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		clean[i] = redactAttr(attr, secrets)
+	}
+
+	return &redactingHandler{next: h.next.WithAttrs(clean), secrets: h.secrets}
+}
+
+// WithGroup implements slog.Handler
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name), secrets: h.secrets}
+}
+
+// currentSecrets returns the non-empty secrets known at the time of logging
+func (h *redactingHandler) currentSecrets() []string {
+	if h.secrets == nil {
+		return nil
+	}
+
+	var secrets []string
+	for _, s := range h.secrets() {
+		if s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+	return secrets
+}
+
+// redactAttr redacts a single attribute, recursing into groups
+func redactAttr(attr slog.Attr, secrets []string) slog.Attr {
+	if isSensitiveLogKey(attr.Key) {
+		return slog.String(attr.Key, redacted)
+	}
+
+	value := attr.Value.Resolve()
+
+	switch value.Kind() {
+	case slog.KindGroup:
+		group := value.Group()
+		clean := make([]any, len(group))
+		for i, a := range group {
+			clean[i] = redactAttr(a, secrets)
+		}
+		return slog.Group(attr.Key, clean...)
+	case slog.KindString:
+		return slog.String(attr.Key, maskSecrets(value.String(), secrets))
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			return slog.String(attr.Key, maskSecrets(err.Error(), secrets))
+		}
+	}
+
+	return slog.Attr{Key: attr.Key, Value: value}
+}
+
+// isSensitiveLogKey reports whether values logged under key must be redacted
+func isSensitiveLogKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	return sensitiveLogKeys[normalized]
+}
+
+// maskSecrets replaces every occurrence of the given secrets in s
+func maskSecrets(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if strings.Contains(s, secret) {
+			s = strings.ReplaceAll(s, secret, redacted)
+		}
+	}
+	return s
+}
@@ -0,0 +1,113 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRedactingHandler(t *testing.T) {
+	secrets := func() []string { return []string{"sk_live_123", "", "eyJaccess"} }
+
+	tests := []struct {
+		name     string
+		log      func(logger *slog.Logger)
+		wantText []string
+		badText  []string
+	}{
+		{
+			name: "sensitive keys",
+			log: func(logger *slog.Logger) {
+				logger.Info("auth", "api_key", "anything", "Authorization", "Bearer abc", "refreshToken", "xyz")
+			},
+			wantText: []string{"api_key=[REDACTED]", "Authorization=[REDACTED]", "refreshToken=[REDACTED]"},
+			badText:  []string{"anything", "Bearer abc", "xyz"},
+		},
+		{
+			name: "secret values in strings and errors",
+			log: func(logger *slog.Logger) {
+				logger.Info("login with sk_live_123", "url", "https://x?token=eyJaccess", "error", errors.New("bad key sk_live_123"))
+			},
+			wantText: []string{"login with [REDACTED]", `url="https://x?token=[REDACTED]"`, `error="bad key [REDACTED]"`},
+			badText:  []string{"sk_live_123", "eyJaccess"},
+		},
+		{
+			name: "groups and attrs",
+			log: func(logger *slog.Logger) {
+				logger.With("token", "abc").Info("request", slog.Group("auth", "key", "sk_other"), "status", 200)
+			},
+			wantText: []string{"token=[REDACTED]", "auth.key=[REDACTED]", "status=200"},
+			badText:  []string{"abc", "sk_other"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := newLogger(slog.New(slog.NewTextHandler(&buf, nil)), secrets)
+
+			tt.log(logger)
+
+			out := buf.String()
+			for _, want := range tt.wantText {
+				if !strings.Contains(out, want) {
+					t.Errorf("log output %q does not contain %q", out, want)
+				}
+			}
+			for _, bad := range tt.badText {
+				if strings.Contains(out, bad) {
+					t.Errorf("log output %q leaks %q", out, bad)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_LogsWithoutCredentials(t *testing.T) {
+	accessToken := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix(), "sub": "access"})
+	refreshToken := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix(), "sub": "refresh"})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/login/key" {
+			_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: accessToken, RefreshToken: refreshToken})
+			return
+		}
+		// Echo the credentials back to make sure they never reach the log through errors
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	c := New(&Config{
+		IAMBaseURL: server.URL,
+		ApiKey:     "sk_live_secret",
+		Timeout:    time.Second,
+		Logger:     slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+
+	err := c.Get(context.Background(), server.URL+"/works/1", nil, nil)
+	if err == nil {
+		t.Fatal("expected error but got none")
+	}
+	c.(*client).logger.Debug("final error", "error", err)
+
+	out := buf.String()
+	for _, want := range []string{"obtained access token", "status=400", "attempt=1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output does not contain %q:\n%s", want, out)
+		}
+	}
+	for _, secret := range []string{"sk_live_secret", accessToken, refreshToken} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output leaks credential %q:\n%s", secret, out)
+		}
+	}
+}
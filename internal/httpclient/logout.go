@@ -0,0 +1,79 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Logout revokes the refresh token at the IAM service and discards the current tokens,
+// both in memory and in the token store. The next authenticated request logs in again.
+// Local state is cleared even if revocation fails.
+func (c *client) Logout(ctx context.Context) (err error) {
+	ctx, span := c.tracer.Start(ctx, "Auth.Logout")
+	defer func() { EndSpan(span, err) }()
+
+	// Keep other clients sharing the store from saving a token while we clear it
+	unlock, err := c.lockTokenStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	c.authMutex.RLock()
+	state := *c.auth
+	c.authMutex.RUnlock()
+
+	// An expired refresh token is already unusable and would only be rejected. Without an
+	// IAM service there is nowhere to revoke it.
+	if state.RefreshToken != "" && c.config.IAMBaseURL != "" &&
+		(state.RefreshExpiresAt.IsZero() || time.Now().Before(state.RefreshExpiresAt)) {
+		err = c.revokeToken(ctx, state.RefreshToken)
+	}
+
+	c.clearToken(ctx)
+
+	if err != nil {
+		c.logger.WarnContext(ctx, "logged out locally but token revocation failed", "error", err)
+		return err
+	}
+
+	c.logger.DebugContext(ctx, "logged out")
+	return nil
+}
+
+// revokeToken revokes a refresh token at the IAM service
+func (c *client) revokeToken(ctx context.Context, refreshToken string) error {
+	urlStr := fmt.Sprintf("%s/auth/token/revoke", c.config.IAMBaseURL)
+	payload := map[string]string{
+		"refreshToken": refreshToken,
+	}
+
+	// The idempotency key lets the retry policy retry this POST after 5xx and transport errors
+	err := c.makeRequest(WithEndpoint(ctx, "/auth/token/revoke"), http.MethodPost, urlStr, payload, nil, false, newIdempotencyKey())
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return nil
+}
+
+// clearToken discards the current tokens in memory and in the token store
+func (c *client) clearToken(ctx context.Context) {
+	c.authMutex.Lock()
+	if c.auth.AccessToken != "" {
+		c.rejectedToken = c.auth.AccessToken
+	}
+	*c.auth = AuthState{}
+	c.authMutex.Unlock()
+
+	c.notifyTokenUpdated()
+
+	if c.tokenStore == nil {
+		return
+	}
+	if err := c.tokenStore.Clear(ctx); err != nil {
+		c.logger.WarnContext(ctx, "failed to clear token store", "error", err)
+	}
+}
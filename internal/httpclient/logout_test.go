@@ -0,0 +1,80 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+func TestClient_Logout(t *testing.T) {
+	tests := []struct {
+		name         string
+		revokeStatus int
+		wantErr      bool
+	}{
+		{name: "revocation succeeds", revokeStatus: http.StatusNoContent},
+		{name: "revocation fails", revokeStatus: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logins atomic.Int32
+			var revoked atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/auth/login/key":
+					n := logins.Add(1)
+					access := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix(), "n": n})
+					refresh := createTestToken(map[string]interface{}{"exp": time.Now().Add(24 * time.Hour).Unix(), "n": n})
+					_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: access, RefreshToken: refresh})
+				case "/auth/token/revoke":
+					var body map[string]string
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					revoked.Store(body["refreshToken"])
+					w.WriteHeader(tt.revokeStatus)
+				default:
+					_, _ = w.Write([]byte(`{}`))
+				}
+			}))
+			defer server.Close()
+
+			store := auth.NewMemoryTokenStore()
+			c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second, TokenStore: store})
+			ctx := context.Background()
+
+			if err := c.Get(ctx, server.URL+"/works/1", nil, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			refreshToken := c.(*client).auth.RefreshToken
+
+			err := c.Logout(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Logout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got, _ := revoked.Load().(string); got != refreshToken {
+				t.Errorf("revoked token = %q, want the client's refresh token", got)
+			}
+			if token := c.(*client).accessToken(); token != "" {
+				t.Error("access token kept after Logout")
+			}
+			if stored, _ := store.Load(ctx); stored != nil {
+				t.Errorf("token store still holds %+v after Logout", stored)
+			}
+
+			// The next request logs in again
+			if err := c.Get(ctx, server.URL+"/works/1", nil, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := logins.Load(); got != 2 {
+				t.Errorf("server received %d logins, want 2", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package httpclient
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// Metrics receives measurements from the client. Implementations must be safe for concurrent use.
+type Metrics interface {
+	// ObserveRequest records the duration of a single attempt. statusCode is 0 when the
+	// request failed at the transport level.
+	ObserveRequest(method, endpoint string, statusCode int, duration time.Duration)
+	// IncRetry counts a retry of a request to the given endpoint
+	IncRetry(method, endpoint string)
+	// IncTokenRefresh counts a token acquisition; authMethod is "refresh", "api_key" or "token_source"
+	IncTokenRefresh(authMethod string, success bool)
+	// AddInFlight adjusts the number of requests currently in flight
+	AddInFlight(delta int)
+}
+
+// noopMetrics is the Metrics used when none is configured
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveRequest(string, string, int, time.Duration) {}
+func (noopMetrics) IncRetry(string, string)                           {}
+func (noopMetrics) IncTokenRefresh(string, bool)                      {}
+func (noopMetrics) AddInFlight(int)                                   {}
+
+// endpointContextKey is the context key for endpoint templates
+type endpointContextKey struct{}
+
+// WithEndpoint returns a copy of ctx carrying the endpoint template (e.g. "/works/{identifier}")
+// reported to Metrics for requests made with it, keeping metric cardinality bounded
+func WithEndpoint(ctx context.Context, template string) context.Context {
+	return context.WithValue(ctx, endpointContextKey{}, template)
+}
+
+// endpointFor returns the endpoint template carried by ctx, falling back to the URL path
+func endpointFor(ctx context.Context, urlStr string) string {
+	if template, ok := ctx.Value(endpointContextKey{}).(string); ok && template != "" {
+		return template
+	}
+
+	if parsed, err := url.Parse(urlStr); err == nil && parsed.Path != "" {
+		return parsed.Path
+	}
+
+	return urlStr
+}
@@ -0,0 +1,140 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordingMetrics records every measurement it receives
+type recordingMetrics struct {
+	mu           sync.Mutex
+	requests     []string
+	retries      []string
+	tokenRefresh []string
+	inFlight     int
+	maxInFlight  int
+}
+
+func (m *recordingMetrics) ObserveRequest(method, endpoint string, statusCode int, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, method+" "+endpoint+" "+http.StatusText(statusCode))
+}
+
+func (m *recordingMetrics) IncRetry(method, endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retries = append(m.retries, method+" "+endpoint)
+}
+
+func (m *recordingMetrics) IncTokenRefresh(authMethod string, success bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := "failure"
+	if success {
+		result = "success"
+	}
+	m.tokenRefresh = append(m.tokenRefresh, authMethod+" "+result)
+}
+
+func (m *recordingMetrics) AddInFlight(delta int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inFlight += delta
+	if m.inFlight > m.maxInFlight {
+		m.maxInFlight = m.inFlight
+	}
+}
+
+func TestClient_ReportsMetrics(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/login/key" {
+			token := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix()})
+			_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: token, RefreshToken: token})
+			return
+		}
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	metrics := &recordingMetrics{}
+	c := New(&Config{
+		IAMBaseURL: server.URL,
+		ApiKey:     "test-key",
+		Timeout:    time.Second,
+		MaxRetries: 1,
+		Metrics:    metrics,
+	})
+
+	ctx := WithEndpoint(context.Background(), "/works/{identifier}")
+	if err := c.Get(ctx, server.URL+"/works/abc", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantRequests := []string{
+		"POST /auth/login/key OK",
+		"GET /works/{identifier} Service Unavailable",
+		"GET /works/{identifier} OK",
+	}
+	if len(metrics.requests) != len(wantRequests) {
+		t.Fatalf("requests = %v, want %v", metrics.requests, wantRequests)
+	}
+	for i := range wantRequests {
+		if metrics.requests[i] != wantRequests[i] {
+			t.Errorf("requests[%d] = %q, want %q", i, metrics.requests[i], wantRequests[i])
+		}
+	}
+
+	if len(metrics.retries) != 1 || metrics.retries[0] != "GET /works/{identifier}" {
+		t.Errorf("retries = %v, want [GET /works/{identifier}]", metrics.retries)
+	}
+	if len(metrics.tokenRefresh) != 1 || metrics.tokenRefresh[0] != "api_key success" {
+		t.Errorf("token refreshes = %v, want [api_key success]", metrics.tokenRefresh)
+	}
+	if metrics.inFlight != 0 || metrics.maxInFlight != 1 {
+		t.Errorf("in flight = %d (max %d), want 0 (max 1)", metrics.inFlight, metrics.maxInFlight)
+	}
+}
+
+func TestEndpointFor(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		url  string
+		want string
+	}{
+		{
+			name: "template from context",
+			ctx:  WithEndpoint(context.Background(), "/people/{identifier}"),
+			url:  "https://catalogue.example.com/people/abc?x=1",
+			want: "/people/{identifier}",
+		},
+		{
+			name: "falls back to path",
+			ctx:  context.Background(),
+			url:  "https://catalogue.example.com/people/abc?x=1",
+			want: "/people/abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := endpointFor(tt.ctx, tt.url); got != tt.want {
+				t.Errorf("endpointFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
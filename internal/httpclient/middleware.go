@@ -0,0 +1,29 @@
+package httpclient
+
+import (
+	"errors"
+	"net/http"
+)
+
+// errNilResponse is reported when the middleware chain returns neither a response nor an error
+var errNilResponse = errors.New("middleware returned a nil *http.Response with a nil error")
+
+// RoundTripFunc sends a single HTTP attempt and returns its response
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of every attempt, including retries and the IAM
+// token calls. It may mutate the request, observe the response, or short-circuit
+// by returning a response or error without calling next. Returning neither fails the attempt.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// chainMiddleware wraps base with the given middleware.
+// The first middleware is the outermost and sees the request first.
+func chainMiddleware(base RoundTripFunc, middleware []Middleware) RoundTripFunc {
+	next := base
+	for i := len(middleware) - 1; i >= 0; i-- {
+		if middleware[i] != nil {
+			next = middleware[i](next)
+		}
+	}
+	return next
+}
@@ -0,0 +1,143 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestChainMiddleware_Order(t *testing.T) {
+	var calls []string
+
+	record := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next(req)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+
+	base := func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "send")
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+	}
+
+	send := chainMiddleware(base, []Middleware{record("first"), nil, record("second")})
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	if _, err := send(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"first before", "second before", "send", "second after", "first after"}
+	if strings.Join(calls, ",") != strings.Join(want, ",") {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestClient_MiddlewareSeesEveryAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "acme" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/auth/login/key":
+			token := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix()})
+			_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: token, RefreshToken: token})
+		default:
+			_, _ = w.Write([]byte(`{"id":"work123"}`))
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var paths []string
+
+	tenant := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Tenant", "acme")
+			mu.Lock()
+			paths = append(paths, req.URL.Path)
+			mu.Unlock()
+			return next(req)
+		}
+	}
+
+	c := New(&Config{
+		IAMBaseURL:       server.URL,
+		CatalogueBaseURL: server.URL,
+		ApiKey:           "test-key",
+		Timeout:          time.Second,
+		Middleware:       []Middleware{tenant},
+	})
+
+	var result map[string]string
+	if err := c.Get(context.Background(), server.URL+"/works/work123", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "/auth/login/key,/works/work123"
+	if got := strings.Join(paths, ","); got != want {
+		t.Errorf("middleware saw %q, want %q", got, want)
+	}
+}
+
+func TestClient_MiddlewareShortCircuit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+
+	stub := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"id":"stubbed"}`)),
+			}, nil
+		}
+	}
+
+	c := New(&Config{Timeout: time.Second, Middleware: []Middleware{stub}})
+
+	var result map[string]string
+	if err := c.(*client).makeRequest(context.Background(), http.MethodGet, server.URL, nil, &result, false, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result["id"] != "stubbed" {
+		t.Errorf("result id = %q, want %q", result["id"], "stubbed")
+	}
+}
+
+func TestClient_MiddlewareNilResponse(t *testing.T) {
+	stub := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return nil, nil
+		}
+	}
+
+	c := New(&Config{Timeout: time.Second, Middleware: []Middleware{stub}})
+
+	err := c.(*client).makeRequest(context.Background(), http.MethodGet, "http://example.invalid", nil, nil, false, "")
+	if !errors.Is(err, errNilResponse) {
+		t.Fatalf("expected errNilResponse, got %v", err)
+	}
+
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) {
+		t.Errorf("expected a RequestError, got %T", err)
+	}
+}
@@ -0,0 +1,96 @@
+package httpclient
+
+import "time"
+
+// minRefreshRetryDelay is the minimum wait before a failed renewal is retried
+const minRefreshRetryDelay = time.Second
+
+// maxRefreshRetryDelay caps the backoff between renewals that keep yielding tokens already due
+const maxRefreshRetryDelay = 5 * time.Minute
+
+// refreshDeadline returns when a token issued at issuedAt and expiring at expiresAt should be
+// renewed. The refresh window is capped at half the token's lifetime so short-lived tokens are
+// still used; the clock skew is always applied in full, since the token may expire that much
+// earlier at the IAM service.
+func refreshDeadline(issuedAt, expiresAt time.Time, window, skew time.Duration) time.Time {
+	lifetime := expiresAt.Sub(issuedAt)
+	window = min(window, lifetime/2)
+	window = max(window, 0)
+	skew = max(skew, 0)
+
+	return expiresAt.Add(-window - skew)
+}
+
+// renewalBackoff returns how long to wait before renewing again after attempt consecutive
+// renewals produced a token that was already due for renewal
+func renewalBackoff(retryDelay time.Duration, attempt int) time.Duration {
+	return exponentialDelay(max(retryDelay, minRefreshRetryDelay), maxRefreshRetryDelay, attempt)
+}
+
+// notifyTokenUpdated wakes the background refresh loop without blocking
+func (c *client) notifyTokenUpdated() {
+	select {
+	case c.tokenUpdated <- struct{}{}:
+	default:
+	}
+}
+
+// refreshLoop renews the access token ahead of its expiry until the client is closed.
+// It waits for the first token to be acquired by a request before scheduling anything.
+func (c *client) refreshLoop() {
+	defer close(c.refreshDone)
+
+	for {
+		c.authMutex.RLock()
+		hasToken := c.auth.AccessToken != "" && !c.auth.RefreshAt.IsZero()
+		refreshAt := c.auth.RefreshAt
+		c.authMutex.RUnlock()
+
+		var timer *time.Timer
+		var due <-chan time.Time
+		if hasToken {
+			timer = time.NewTimer(time.Until(refreshAt))
+			due = timer.C
+		}
+
+		select {
+		case <-c.closeCtx.Done():
+			stopTimer(timer)
+			return
+		case <-c.tokenUpdated:
+			stopTimer(timer)
+			continue
+		case <-due:
+		}
+
+		c.logger.DebugContext(c.closeCtx, "renewing access token in background")
+
+		if err := c.acquireTokenShared(c.closeCtx); err != nil {
+			if c.closeCtx.Err() != nil {
+				return
+			}
+
+			c.logger.WarnContext(c.closeCtx, "background token renewal failed", "error", err)
+
+			if sleep(c.closeCtx, max(c.config.RetryDelay, minRefreshRetryDelay)) != nil {
+				return
+			}
+		}
+	}
+}
+
+// stopTimer stops timer if it is non-nil
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// Close stops the background token refresh, if running. It is safe to call more than once.
+func (c *client) Close() error {
+	c.closeOnce.Do(func() {
+		c.closeFunc()
+		<-c.refreshDone
+	})
+	return nil
+}
@@ -0,0 +1,60 @@
+package httpclient
+
+import "net/http"
+
+// IdempotencyKeyHeader is the header used to let the server de-duplicate retried writes
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RetryPolicy decides whether a failed attempt should be retried.
+// attempt is the number of attempts made so far (1 after the first attempt).
+// resp is nil when the request failed at the transport level; otherwise its body
+// has already been consumed and closed. err is the error produced by the attempt.
+// The client stops retrying once MaxRetries is reached regardless of the policy.
+type RetryPolicy interface {
+	ShouldRetry(req *http.Request, attempt int, resp *http.Response, err error) bool
+}
+
+// RetryPolicyFunc adapts an ordinary function to the RetryPolicy interface
+type RetryPolicyFunc func(req *http.Request, attempt int, resp *http.Response, err error) bool
+
+// ShouldRetry calls f(req, attempt, resp, err)
+func (f RetryPolicyFunc) ShouldRetry(req *http.Request, attempt int, resp *http.Response, err error) bool {
+	return f(req, attempt, resp, err)
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured.
+// Rate limited requests (429) are always retried since the server did not process them.
+// Transport errors and server errors (5xx) are only retried for idempotent methods or
+// requests carrying an Idempotency-Key header, so writes are never applied twice.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicyFunc(func(req *http.Request, _ int, resp *http.Response, err error) bool {
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			return true
+		}
+
+		if resp != nil && resp.StatusCode < 500 {
+			return false
+		}
+
+		if resp == nil && err == nil {
+			return false
+		}
+
+		return IsIdempotent(req)
+	})
+}
+
+// IsIdempotent reports whether req can safely be sent more than once, either because its
+// method is idempotent or because it carries an Idempotency-Key header
+func IsIdempotent(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return req.Header.Get(IdempotencyKeyHeader) != ""
+}
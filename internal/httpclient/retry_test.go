@@ -0,0 +1,152 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	newRequest := func(method string, header map[string]string) *http.Request {
+		req := httptest.NewRequest(method, "http://example.com", nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		return req
+	}
+
+	transportErr := errors.New("connection reset")
+
+	tests := []struct {
+		name   string
+		req    *http.Request
+		status int
+		err    error
+		want   bool
+	}{
+		{
+			name: "GET transport error",
+			req:  newRequest(http.MethodGet, nil),
+			err:  transportErr,
+			want: true,
+		},
+		{
+			name: "POST transport error",
+			req:  newRequest(http.MethodPost, nil),
+			err:  transportErr,
+			want: false,
+		},
+		{
+			name: "POST with idempotency key transport error",
+			req:  newRequest(http.MethodPost, map[string]string{IdempotencyKeyHeader: "abc"}),
+			err:  transportErr,
+			want: true,
+		},
+		{
+			name:   "GET server error",
+			req:    newRequest(http.MethodGet, nil),
+			status: http.StatusBadGateway,
+			want:   true,
+		},
+		{
+			name:   "PATCH server error",
+			req:    newRequest(http.MethodPatch, nil),
+			status: http.StatusInternalServerError,
+			want:   false,
+		},
+		{
+			name:   "PUT server error",
+			req:    newRequest(http.MethodPut, nil),
+			status: http.StatusServiceUnavailable,
+			want:   true,
+		},
+		{
+			name:   "POST rate limited",
+			req:    newRequest(http.MethodPost, nil),
+			status: http.StatusTooManyRequests,
+			want:   true,
+		},
+		{
+			name:   "GET not found",
+			req:    newRequest(http.MethodGet, nil),
+			status: http.StatusNotFound,
+			want:   false,
+		},
+	}
+
+	policy := DefaultRetryPolicy()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp *http.Response
+			err := tt.err
+			if tt.status != 0 {
+				resp = &http.Response{StatusCode: tt.status, Header: http.Header{}}
+				err = &APIError{StatusCode: tt.status}
+			}
+
+			if got := policy.ShouldRetry(tt.req, 1, resp, err); got != tt.want {
+				t.Errorf("ShouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteWithRetry_UsesRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		policy    RetryPolicy
+		wantCalls int32
+	}{
+		{
+			name:      "default policy retries GET",
+			method:    http.MethodGet,
+			wantCalls: 3,
+		},
+		{
+			name:      "default policy does not retry POST",
+			method:    http.MethodPost,
+			wantCalls: 1,
+		},
+		{
+			name:   "custom policy stops after second attempt",
+			method: http.MethodGet,
+			policy: RetryPolicyFunc(func(_ *http.Request, attempt int, _ *http.Response, _ error) bool {
+				return attempt < 2
+			}),
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			c := New(&Config{
+				Timeout:     time.Second,
+				MaxRetries:  2,
+				RetryPolicy: tt.policy,
+			})
+
+			err := c.(*client).makeRequest(context.Background(), tt.method, server.URL, nil, nil, false, "")
+			if err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("server received %d calls, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
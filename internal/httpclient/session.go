@@ -0,0 +1,33 @@
+package httpclient
+
+import (
+	"context"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+// Session authenticates if needed and returns the decoded claims of the current tokens
+func (c *client) Session(ctx context.Context) (*auth.Session, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	c.authMutex.RLock()
+	state := *c.auth
+	c.authMutex.RUnlock()
+
+	session := &auth.Session{
+		ExpiresAt:        state.ExpiresAt,
+		RefreshExpiresAt: state.RefreshExpiresAt,
+	}
+
+	// Opaque tokens have no claims to report
+	if claims, err := auth.ParseClaims(state.AccessToken); err == nil {
+		session.Access = claims
+	}
+	if claims, err := auth.ParseClaims(state.RefreshToken); err == nil {
+		session.Refresh = claims
+	}
+
+	return session, nil
+}
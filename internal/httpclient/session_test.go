@@ -0,0 +1,55 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+func TestClient_Session(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	access := createTestToken(map[string]interface{}{"sub": "user-1", "iss": "iam", "scope": "works:read works:write", "exp": exp.Unix()})
+	refresh := createTestToken(map[string]interface{}{"sub": "user-1", "exp": exp.Add(24 * time.Hour).Unix()})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: access, RefreshToken: refresh})
+	}))
+	defer server.Close()
+
+	c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second})
+
+	session, err := c.Session(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if session.Subject() != "user-1" || session.Access.Issuer != "iam" {
+		t.Errorf("access claims = %+v", session.Access)
+	}
+	if !session.HasScope("works:write") || session.HasScope("people:write") {
+		t.Errorf("scopes = %v", session.Access.Scopes)
+	}
+	if !session.ExpiresAt.Equal(exp) {
+		t.Errorf("ExpiresAt = %v, want %v", session.ExpiresAt, exp)
+	}
+	if session.Refresh == nil || !session.Refresh.ExpiresAt.Equal(session.RefreshExpiresAt) {
+		t.Errorf("refresh claims = %+v, RefreshExpiresAt = %v", session.Refresh, session.RefreshExpiresAt)
+	}
+}
+
+func TestClient_SessionWithOpaqueToken(t *testing.T) {
+	c := New(&Config{TokenSource: auth.StaticTokenSource("opaque"), Timeout: time.Second})
+
+	session, err := c.Session(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session.Access != nil || session.Refresh != nil || session.HasScope("any") {
+		t.Errorf("session = %+v, want no claims", session)
+	}
+}
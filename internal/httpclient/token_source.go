@@ -0,0 +1,75 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+// newTokenSource returns the token source configured for the client and the name it is
+// reported under. A caller-supplied source takes precedence over API key login.
+func newTokenSource(c *client, config *Config) (auth.TokenSource, string) {
+	if config.TokenSource != nil {
+		return config.TokenSource, "token_source"
+	}
+
+	if config.ApiKey != "" {
+		return &apiKeyTokenSource{client: c}, "api_key"
+	}
+
+	return nil, ""
+}
+
+// apiKeyTokenSource obtains tokens by exchanging the configured API key at the IAM service
+type apiKeyTokenSource struct {
+	client *client
+}
+
+// Token implements auth.TokenSource
+func (s *apiKeyTokenSource) Token(ctx context.Context) (*auth.Token, error) {
+	c := s.client
+	urlStr := fmt.Sprintf("%s/auth/login/key", c.config.IAMBaseURL)
+	payload := map[string]string{
+		"key": c.config.ApiKey,
+	}
+
+	var token TokenPair
+
+	// Make unauthenticated request to avoid infinite recursion. Its own idempotency key,
+	// never the caller's, lets the retry policy retry this POST after 5xx and transport errors.
+	err := c.makeRequest(WithEndpoint(ctx, "/auth/login/key"), http.MethodPost, urlStr, payload, &token, false, newIdempotencyKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	return tokenFromPair(token), nil
+}
+
+// tokenFromPair converts a token pair issued by the IAM service, applying default
+// lifetimes when the tokens' expiry cannot be read
+func tokenFromPair(pair TokenPair) *auth.Token {
+	now := time.Now()
+
+	// Parse expiration times from tokens
+	accessExpiry, err := GetTokenExpiration(pair.AccessToken)
+	if err != nil {
+		// If we can't parse expiration, set a reasonable default (1 hour)
+		accessExpiry = now.Add(1 * time.Hour)
+	}
+
+	refreshExpiry, err := GetTokenExpiration(pair.RefreshToken)
+	if err != nil {
+		// If we can't parse expiration, set a reasonable default (7 days)
+		refreshExpiry = now.Add(7 * 24 * time.Hour)
+	}
+
+	return &auth.Token{
+		AccessToken:      pair.AccessToken,
+		RefreshToken:     pair.RefreshToken,
+		ExpiresAt:        accessExpiry,
+		RefreshExpiresAt: refreshExpiry,
+	}
+}
@@ -0,0 +1,111 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+func TestClient_TokenSource(t *testing.T) {
+	expired := createTestToken(map[string]interface{}{"exp": time.Now().Add(-time.Minute).Unix()})
+
+	tests := []struct {
+		name        string
+		tokens      []string // returned by successive calls to the source
+		requests    int
+		wantCalls   int32
+		wantBearers []string
+	}{
+		{
+			name:        "opaque static token is reused",
+			tokens:      []string{"opaque-token"},
+			requests:    3,
+			wantCalls:   1,
+			wantBearers: []string{"opaque-token", "opaque-token", "opaque-token"},
+		},
+		{
+			name:        "expired token is not fetched again on every request",
+			tokens:      []string{expired, "second-token"},
+			requests:    3,
+			wantCalls:   1,
+			wantBearers: []string{expired, expired, expired},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logins atomic.Int32
+			var bearers []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasPrefix(r.URL.Path, "/auth/") {
+					logins.Add(1)
+				}
+				bearers = append(bearers, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			var calls atomic.Int32
+			source := auth.TokenSourceFunc(func(context.Context) (*auth.Token, error) {
+				n := int(calls.Add(1))
+				return &auth.Token{AccessToken: tt.tokens[min(n, len(tt.tokens))-1]}, nil
+			})
+
+			c := New(&Config{
+				IAMBaseURL:  server.URL,
+				ApiKey:      "ignored-key",
+				TokenSource: source,
+				Timeout:     time.Second,
+			})
+
+			for i := 0; i < tt.requests; i++ {
+				if err := c.Get(context.Background(), server.URL+"/works/1", nil, nil); err != nil {
+					t.Fatalf("request %d: unexpected error: %v", i, err)
+				}
+			}
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("token source called %d times, want %d", got, tt.wantCalls)
+			}
+			if got := logins.Load(); got != 0 {
+				t.Errorf("server received %d IAM calls, want 0", got)
+			}
+			if strings.Join(bearers, ",") != strings.Join(tt.wantBearers, ",") {
+				t.Errorf("bearer tokens = %v, want %v", bearers, tt.wantBearers)
+			}
+		})
+	}
+}
+
+func TestClient_StaticTokenSource(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := New(&Config{IAMBaseURL: server.URL, TokenSource: auth.StaticTokenSource("pre-issued"), Timeout: time.Second})
+
+	if err := c.Get(context.Background(), server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if authorization != "Bearer pre-issued" {
+		t.Errorf("Authorization = %q, want %q", authorization, "Bearer pre-issued")
+	}
+}
+
+func TestClient_NoCredentials(t *testing.T) {
+	c := New(&Config{IAMBaseURL: "http://127.0.0.1:0", Timeout: time.Second})
+
+	err := c.Get(context.Background(), "http://127.0.0.1:0/works/1", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "no API key or token source") {
+		t.Fatalf("error = %v, want missing credentials error", err)
+	}
+}
@@ -0,0 +1,64 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+// errNoCredentials is returned when the client has no way to obtain an access token
+var errNoCredentials = errors.New("no API key or token source provided for authentication")
+
+// loadStoredToken adopts the token in the token store if it differs from the current one
+// and has not been rejected by the server. It reports whether a token was adopted.
+func (c *client) loadStoredToken(ctx context.Context) bool {
+	if c.tokenStore == nil {
+		return false
+	}
+
+	token, err := c.tokenStore.Load(ctx)
+	if err != nil {
+		c.logger.WarnContext(ctx, "failed to load token from token store", "error", err)
+		return false
+	}
+	if token == nil || token.AccessToken == "" {
+		return false
+	}
+
+	c.authMutex.RLock()
+	known := token.AccessToken == c.auth.AccessToken || token.AccessToken == c.rejectedToken
+	c.authMutex.RUnlock()
+	if known {
+		return false
+	}
+
+	c.applyToken(ctx, token, false)
+	return true
+}
+
+// saveToken persists token to the token store. Failures are logged rather than returned:
+// the token is still usable by this client.
+func (c *client) saveToken(ctx context.Context, token *auth.Token) {
+	if c.tokenStore == nil {
+		return
+	}
+
+	if err := c.tokenStore.Save(ctx, token); err != nil {
+		c.logger.WarnContext(ctx, "failed to save token to token store", "error", err)
+	}
+}
+
+// lockTokenStore acquires the token store's lock if it has one, and returns a function releasing it
+func (c *client) lockTokenStore(ctx context.Context) (func(), error) {
+	locker, ok := c.tokenStore.(auth.Locker)
+	if !ok {
+		return func() {}, nil
+	}
+
+	unlock, err := locker.Lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unlock, nil
+}
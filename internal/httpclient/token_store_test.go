@@ -0,0 +1,108 @@
+package httpclient
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+func TestClient_TokenStore(t *testing.T) {
+	server := newAuthServer(t, time.Hour, 0)
+	store := auth.NewMemoryTokenStore()
+	ctx := context.Background()
+
+	first := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second, TokenStore: store})
+	if err := first.Get(ctx, server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved, _ := store.Load(ctx)
+	if saved == nil || saved.AccessToken != first.(*client).accessToken() || saved.ExpiresAt.IsZero() {
+		t.Fatalf("stored token = %+v, want the client's token with its expiry", saved)
+	}
+
+	// A restarted client reuses the stored token without logging in
+	second := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second, TokenStore: store})
+	if err := second.Get(ctx, server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := server.logins.Load(); got != 1 {
+		t.Errorf("server received %d logins, want 1", got)
+	}
+
+	// An expired stored token is refreshed rather than replaced by a new login
+	saved.ExpiresAt = time.Now().Add(-time.Minute)
+	saved.AccessToken = "expired"
+	_ = store.Save(ctx, saved)
+
+	third := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second, TokenStore: store})
+	if err := third.Get(ctx, server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logins, refreshes := server.logins.Load(), server.refreshes.Load(); logins != 1 || refreshes != 1 {
+		t.Errorf("server received %d logins and %d refreshes, want 1 and 1", logins, refreshes)
+	}
+	if saved, _ := store.Load(ctx); saved.AccessToken != third.(*client).accessToken() {
+		t.Error("refreshed token was not saved to the store")
+	}
+}
+
+func TestClient_SharedFileTokenStoreLogsInOnce(t *testing.T) {
+	server := newAuthServer(t, time.Hour, 50*time.Millisecond)
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	// Separate stores on the same file behave like separate processes
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		store := auth.NewFileTokenStore(path, auth.WithFileLocking(0))
+		c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second, TokenStore: store})
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- c.Get(context.Background(), server.URL+"/works/1", nil, nil)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := server.logins.Load(); got != 1 {
+		t.Errorf("server received %d logins, want 1", got)
+	}
+}
+
+func TestClient_StoredTokenInsideClockSkewIsRenewed(t *testing.T) {
+	server := newAuthServer(t, time.Hour, 0)
+	store := auth.NewMemoryTokenStore()
+	ctx := context.Background()
+
+	// Issued an hour ago and expiring in 8s: within the clock skew, so possibly already expired at IAM
+	stored := createTestToken(map[string]interface{}{
+		"iat": time.Now().Add(-time.Hour).Unix(),
+		"exp": time.Now().Add(8 * time.Second).Unix(),
+	})
+	refresh := createTestToken(map[string]interface{}{"exp": time.Now().Add(24 * time.Hour).Unix()})
+	_ = store.Save(ctx, &auth.Token{AccessToken: stored, RefreshToken: refresh})
+
+	c := New(&Config{IAMBaseURL: server.URL, ApiKey: "test-key", Timeout: time.Second, TokenStore: store, ClockSkew: 10 * time.Second})
+	if err := c.Get(ctx, server.URL+"/works/1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := server.refreshes.Load(); got != 1 {
+		t.Errorf("server received %d refreshes, want 1", got)
+	}
+	if c.(*client).accessToken() == stored {
+		t.Error("stored token was used although it expires within the clock skew")
+	}
+}
@@ -0,0 +1,51 @@
+package httpclient
+
+import "context"
+
+// TraceParentHeader is the W3C Trace Context header used to propagate spans
+const TraceParentHeader = "traceparent"
+
+// Tracer starts spans for SDK operations. It mirrors the shape of an OpenTelemetry
+// tracer so an adapter is a few lines, without the SDK depending on OpenTelemetry.
+type Tracer interface {
+	// Start creates a span as a child of any span in ctx and returns a context carrying it
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced operation
+type Span interface {
+	// SetAttribute records a key/value attribute on the span
+	SetAttribute(key string, value any)
+	// RecordError records err on the span and marks it as failed
+	RecordError(err error)
+	// TraceParent returns the W3C traceparent header value identifying the span,
+	// or an empty string to skip propagation
+	TraceParent() string
+	// End completes the span
+	End()
+}
+
+// noopTracer is the Tracer used when none is configured
+type noopTracer struct{}
+
+// Start implements Tracer
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+// noopSpan is the Span returned by noopTracer
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, any) {}
+func (noopSpan) RecordError(error)        {}
+func (noopSpan) TraceParent() string      { return "" }
+func (noopSpan) End()                     {}
+
+// EndSpan records err on span if it is non-nil and ends the span.
+// It is meant to be deferred with a named error result.
+func EndSpan(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
@@ -0,0 +1,162 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordingTracer records every span it starts
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordingSpan
+	next  atomic.Int32
+}
+
+type recordingSpan struct {
+	name   string
+	id     int32
+	parent int32
+	mu     sync.Mutex
+	attrs  map[string]any
+	err    error
+	ended  bool
+}
+
+type spanContextKey struct{}
+
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &recordingSpan{name: name, id: t.next.Add(1), attrs: map[string]any{}}
+	if parent, ok := ctx.Value(spanContextKey{}).(*recordingSpan); ok {
+		span.parent = parent.id
+	}
+
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	t.mu.Unlock()
+
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+func (t *recordingTracer) byName(name string) []*recordingSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var spans []*recordingSpan
+	for _, s := range t.spans {
+		if s.name == name {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+func (s *recordingSpan) SetAttribute(key string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attrs[key] = value
+}
+
+func (s *recordingSpan) RecordError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *recordingSpan) TraceParent() string {
+	return fmt.Sprintf("00-%032x-%016x-01", 1, s.id)
+}
+
+func (s *recordingSpan) End() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ended = true
+}
+
+func TestClient_Tracing(t *testing.T) {
+	var calls atomic.Int32
+	var mu sync.Mutex
+	traceParents := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		traceParents[r.URL.Path] = r.Header.Get(TraceParentHeader)
+		mu.Unlock()
+
+		if r.URL.Path == "/auth/login/key" {
+			token := createTestToken(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix()})
+			_ = json.NewEncoder(w).Encode(TokenPair{AccessToken: token, RefreshToken: token})
+			return
+		}
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	c := New(&Config{
+		IAMBaseURL: server.URL,
+		ApiKey:     "test-key",
+		Timeout:    time.Second,
+		MaxRetries: 1,
+		Tracer:     tracer,
+	})
+
+	ctx, parent := c.StartSpan(context.Background(), "WorkService.GetByIdentifier")
+	err := c.Get(ctx, server.URL+"/works/1", nil, nil)
+	EndSpan(parent, err)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	authSpans := tracer.byName("Auth.AcquireToken")
+	if len(authSpans) != 1 {
+		t.Fatalf("got %d Auth.AcquireToken spans, want 1", len(authSpans))
+	}
+	if authSpans[0].parent != parent.(*recordingSpan).id {
+		t.Errorf("Auth.AcquireToken parent = %d, want service span %d", authSpans[0].parent, parent.(*recordingSpan).id)
+	}
+
+	// The retried GET produces one span per attempt
+	attempts := tracer.byName("HTTP GET")
+	if len(attempts) != 2 {
+		t.Fatalf("got %d HTTP GET spans, want 2", len(attempts))
+	}
+	for i, span := range attempts {
+		if !span.ended {
+			t.Errorf("attempt %d span not ended", i)
+		}
+		if span.parent != parent.(*recordingSpan).id {
+			t.Errorf("attempt %d parent = %d, want %d", i, span.parent, parent.(*recordingSpan).id)
+		}
+		if got := span.attrs["http.request.resend_count"]; got != i {
+			t.Errorf("attempt %d resend_count = %v, want %d", i, got, i)
+		}
+	}
+	if got := attempts[0].attrs["http.response.status_code"]; got != http.StatusBadGateway {
+		t.Errorf("first attempt status = %v, want %d", got, http.StatusBadGateway)
+	}
+	if attempts[0].err == nil {
+		t.Error("first attempt span has no recorded error")
+	}
+	if got := attempts[1].attrs["http.response.status_code"]; got != http.StatusOK {
+		t.Errorf("second attempt status = %v, want %d", got, http.StatusOK)
+	}
+
+	if got, want := traceParents["/works/1"], attempts[1].TraceParent(); got != want {
+		t.Errorf("traceparent = %q, want %q", got, want)
+	}
+	if traceParents["/auth/login/key"] == "" {
+		t.Error("login request was sent without traceparent")
+	}
+}
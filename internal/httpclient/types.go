@@ -1,17 +1,48 @@
 package httpclient
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
 	"sync"
 	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
 )
 
 // client is the internal HTTP client implementation
 type client struct {
-	auth       *AuthState
-	authMutex  sync.RWMutex
-	httpClient *http.Client
-	config     *Config
+	auth        *AuthState
+	authMutex   sync.RWMutex
+	httpClient  *http.Client
+	config      *Config
+	backoff     BackoffStrategy
+	retryPolicy RetryPolicy
+	send        RoundTripFunc
+	logger      *slog.Logger
+	tracer      Tracer
+	metrics     Metrics
+	tokenMutex  sync.Mutex
+	tokenCall   *tokenCall
+
+	tokenSource     auth.TokenSource
+	tokenSourceName string // reported in logs, spans and metrics
+	tokenStore      auth.TokenStore
+	rejectedToken   string // last access token rejected with 401, never reloaded from the store
+	dueRenewals     int    // consecutive tokens that were already due for renewal when applied
+	catalogue       *endpointPool
+
+	tokenUpdated chan struct{} // signalled whenever a new token is stored
+	refreshDone  chan struct{} // closed when the background refresh loop exits
+	closeCtx     context.Context
+	closeFunc    context.CancelFunc
+	closeOnce    sync.Once
+}
+
+// tokenCall is an in-flight token acquisition shared by concurrent callers
+type tokenCall struct {
+	done chan struct{}
+	err  error
 }
 
 // auth holds authentication state
@@ -20,6 +51,7 @@ type AuthState struct {
 	RefreshToken     string
 	ExpiresAt        time.Time
 	RefreshExpiresAt time.Time
+	RefreshAt        time.Time // when the access token should be renewed, ahead of ExpiresAt
 }
 
 // TokenPair represents an access/refresh token pair from the server
@@ -30,11 +62,27 @@ type TokenPair struct {
 
 // Config holds configuration for the HTTP client
 type Config struct {
-	IAMBaseURL       string
-	CatalogueBaseURL string
-	ApiKey           string
-	Timeout          time.Duration
-	RetryDelay       time.Duration
-	MaxRetries       int
-	UserAgent        string
+	IAMBaseURL            string
+	CatalogueBaseURL      string
+	CatalogueFallbackURLs []string
+	ApiKey                string
+	TokenSource           auth.TokenSource
+	TokenStore            auth.TokenStore
+	Timeout               time.Duration
+	RetryDelay            time.Duration
+	MaxRetries            int
+	MaxRetryAfter         time.Duration // longest Retry-After honoured; defaults to DefaultMaxRetryAfter
+	UserAgent             string
+	Backoff               BackoffStrategy
+	RetryPolicy           RetryPolicy
+	Middleware            []Middleware
+	HTTPClient            *http.Client
+	Transport             http.RoundTripper
+	Logger                *slog.Logger
+	Tracer                Tracer
+	Metrics               Metrics
+
+	TokenRefreshWindow     time.Duration // renew the access token this long before it expires
+	ClockSkew              time.Duration // tolerated clock difference with the IAM service
+	BackgroundTokenRefresh bool          // renew the access token in a background goroutine
 }
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Claims are the decoded claims of a JWT issued by the IAM service.
+// The signature is not verified: claims are for introspection, not for trust decisions
+// against tokens from untrusted sources.
+type Claims struct {
+	Subject   string         // "sub"
+	Issuer    string         // "iss"
+	Audience  []string       // "aud"
+	Scopes    []string       // "scope" (space separated), "scopes" or "scp"
+	Roles     []string       // "roles" or "role"
+	IssuedAt  time.Time      // "iat"; zero if absent
+	ExpiresAt time.Time      // "exp"; zero if absent
+	NotBefore time.Time      // "nbf"; zero if absent
+	Raw       map[string]any // all claims as decoded from the payload
+}
+
+// HasScope reports whether the claims grant scope
+func (c *Claims) HasScope(scope string) bool {
+	return c != nil && slices.Contains(c.Scopes, scope)
+}
+
+// HasRole reports whether the claims include role
+func (c *Claims) HasRole(role string) bool {
+	return c != nil && slices.Contains(c.Roles, role)
+}
+
+// ParseClaims decodes the payload of a JWT without verifying its signature
+func ParseClaims(token string) (*Claims, error) {
+	if token == "" {
+		return nil, fmt.Errorf("token is empty")
+	}
+
+	// JWT tokens have three parts: header.payload.signature
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid token format: expected 3 parts, got %d", len(parts))
+	}
+
+	// Decode the payload (second part)
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token payload: %w", err)
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse token claims: %w", err)
+	}
+
+	claims := &Claims{
+		Subject:   stringClaim(raw["sub"]),
+		Issuer:    stringClaim(raw["iss"]),
+		Audience:  listClaim(raw["aud"], false),
+		Roles:     append(listClaim(raw["roles"], false), listClaim(raw["role"], false)...),
+		IssuedAt:  timeClaim(raw["iat"]),
+		ExpiresAt: timeClaim(raw["exp"]),
+		NotBefore: timeClaim(raw["nbf"]),
+		Raw:       raw,
+	}
+	for _, key := range []string{"scope", "scopes", "scp"} {
+		claims.Scopes = append(claims.Scopes, listClaim(raw[key], true)...)
+	}
+
+	return claims, nil
+}
+
+// stringClaim returns v if it is a string
+func stringClaim(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+// listClaim returns v as a list of strings. v may be an array or a single string,
+// which is split on whitespace when split is set (as OAuth does for "scope").
+func listClaim(v any, split bool) []string {
+	switch v := v.(type) {
+	case string:
+		if split {
+			return strings.Fields(v)
+		}
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				list = append(list, s)
+			}
+		}
+		return list
+	default:
+		return nil
+	}
+}
+
+// timeClaim converts a NumericDate (seconds since the epoch) to a time
+func timeClaim(v any) time.Time {
+	seconds, ok := v.(float64)
+	if !ok || seconds == 0 {
+		return time.Time{}
+	}
+
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*1e9))
+}
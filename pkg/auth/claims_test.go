@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func testToken(claims map[string]any) string {
+	payload, _ := json.Marshal(claims)
+	return "eyJhbGciOiJIUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestParseClaims(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		wantErr    bool
+		wantScopes []string
+		wantRoles  []string
+		wantAud    []string
+	}{
+		{name: "empty", token: "", wantErr: true},
+		{name: "not a JWT", token: "opaque", wantErr: true},
+		{name: "bad payload", token: "a.!!!.c", wantErr: true},
+		{
+			name:       "space separated scope",
+			token:      testToken(map[string]any{"scope": "works:read works:write", "role": "editor", "aud": "catalogue"}),
+			wantScopes: []string{"works:read", "works:write"},
+			wantRoles:  []string{"editor"},
+			wantAud:    []string{"catalogue"},
+		},
+		{
+			name:       "array claims",
+			token:      testToken(map[string]any{"scopes": []string{"people:read"}, "roles": []string{"admin", "editor"}, "aud": []string{"iam", "catalogue"}}),
+			wantScopes: []string{"people:read"},
+			wantRoles:  []string{"admin", "editor"},
+			wantAud:    []string{"iam", "catalogue"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := ParseClaims(tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slices.Equal(claims.Scopes, tt.wantScopes) {
+				t.Errorf("Scopes = %v, want %v", claims.Scopes, tt.wantScopes)
+			}
+			if !slices.Equal(claims.Roles, tt.wantRoles) {
+				t.Errorf("Roles = %v, want %v", claims.Roles, tt.wantRoles)
+			}
+			if !slices.Equal(claims.Audience, tt.wantAud) {
+				t.Errorf("Audience = %v, want %v", claims.Audience, tt.wantAud)
+			}
+		})
+	}
+}
+
+func TestParseClaims_StandardClaims(t *testing.T) {
+	iat := time.Now().Add(-time.Minute).Truncate(time.Second)
+	exp := iat.Add(time.Hour)
+
+	claims, err := ParseClaims(testToken(map[string]any{
+		"sub":    "user-42",
+		"iss":    "https://iam.nollywood.com",
+		"iat":    iat.Unix(),
+		"exp":    exp.Unix(),
+		"tenant": "acme",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if claims.Subject != "user-42" || claims.Issuer != "https://iam.nollywood.com" {
+		t.Errorf("Subject, Issuer = %q, %q", claims.Subject, claims.Issuer)
+	}
+	if !claims.IssuedAt.Equal(iat) || !claims.ExpiresAt.Equal(exp) {
+		t.Errorf("IssuedAt, ExpiresAt = %v, %v; want %v, %v", claims.IssuedAt, claims.ExpiresAt, iat, exp)
+	}
+	if !claims.NotBefore.IsZero() {
+		t.Errorf("NotBefore = %v, want zero", claims.NotBefore)
+	}
+	if claims.Raw["tenant"] != "acme" {
+		t.Errorf("Raw[tenant] = %v, want acme", claims.Raw["tenant"])
+	}
+}
@@ -0,0 +1,283 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultLockStaleAfter is how long a lock file must go without being refreshed by its
+	// holder before it is considered abandoned. It is well above the time a login can take
+	// with the default timeout and retries, so a slow holder is never mistaken for a crashed one.
+	DefaultLockStaleAfter = 5 * time.Minute
+
+	lockPollInterval = 50 * time.Millisecond
+)
+
+// FileTokenStore stores a token as JSON in a file readable only by its owner.
+// Writes are atomic: the token is written to a temporary file which then replaces the old one.
+type FileTokenStore struct {
+	path           string
+	locking        bool
+	lockStaleAfter time.Duration
+}
+
+var (
+	_ TokenStore = (*FileTokenStore)(nil)
+	_ Locker     = (*FileTokenStore)(nil)
+)
+
+// FileTokenStoreOption configures a FileTokenStore
+type FileTokenStoreOption func(*FileTokenStore)
+
+// WithFileLocking makes the store lock a "<path>.lock" file while a token is being obtained,
+// so several processes sharing the file log in only once. The holder refreshes the lock file
+// while it runs; one not refreshed for staleAfter (DefaultLockStaleAfter if zero) is assumed
+// to belong to a crashed process and is removed.
+func WithFileLocking(staleAfter time.Duration) FileTokenStoreOption {
+	return func(s *FileTokenStore) {
+		s.locking = true
+		if staleAfter > 0 {
+			s.lockStaleAfter = staleAfter
+		}
+	}
+}
+
+// NewFileTokenStore creates a FileTokenStore backed by the file at path.
+// Missing parent directories are created with 0700 permissions on the first save.
+func NewFileTokenStore(path string, opts ...FileTokenStoreOption) *FileTokenStore {
+	s := &FileTokenStore{
+		path:           path,
+		lockStaleAfter: DefaultLockStaleAfter,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Path returns the path of the token file
+func (s *FileTokenStore) Path() string {
+	return s.path
+}
+
+// Load implements TokenStore
+func (s *FileTokenStore) Load(context.Context) (*Token, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse token file %s: %w", s.path, err)
+	}
+	return &token, nil
+}
+
+// Save implements TokenStore
+func (s *FileTokenStore) Save(ctx context.Context, token *Token) error {
+	if token == nil {
+		return s.Clear(ctx)
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create token directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary token file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once renamed
+
+	if err := writeFile(tmp, data); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to replace token file: %w", err)
+	}
+	return nil
+}
+
+// writeFile writes data to f with owner-only permissions, syncs and closes it
+func writeFile(f *os.File, data []byte) error {
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Clear implements TokenStore
+func (s *FileTokenStore) Clear(context.Context) error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
+
+// Lock implements Locker. Without WithFileLocking it returns immediately.
+//
+// The lock file holds a token identifying its owner, and its modification time is refreshed
+// while the lock is held, so only a lock whose owner has stopped (e.g. crashed) goes stale.
+// A lock is only ever removed by a process that has checked it still holds that owner's token.
+func (s *FileTokenStore) Lock(ctx context.Context) (func(), error) {
+	if !s.locking {
+		return func() {}, nil
+	}
+
+	lockPath := s.path + ".lock"
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create token directory: %w", err)
+	}
+
+	owner := newLockOwner()
+	for {
+		acquired, err := createLockFile(lockPath, owner)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			return s.holdLock(lockPath, owner), nil
+		}
+
+		// Break locks left behind by crashed processes
+		if current, info, err := readLockFile(lockPath); err == nil && time.Since(info.ModTime()) > s.lockStaleAfter {
+			releaseLockFile(lockPath, current, owner)
+			continue
+		}
+
+		timer := time.NewTimer(lockPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("waiting for token file lock: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// holdLock keeps the lock file fresh until the returned unlock function is called
+func (s *FileTokenStore) holdLock(lockPath, owner string) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(max(s.lockStaleAfter/4, time.Millisecond))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if current, _, err := readLockFile(lockPath); err == nil && current == owner {
+					now := time.Now()
+					_ = os.Chtimes(lockPath, now, now)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stop)
+			<-done
+			// The lock may have been broken while this process was stalled; leave the new holder's alone
+			if current, _, err := readLockFile(lockPath); err == nil && current == owner {
+				releaseLockFile(lockPath, owner, owner)
+			}
+		})
+	}
+}
+
+// newLockOwner returns a random token identifying a lock holder
+func newLockOwner() string {
+	var b [16]byte
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// createLockFile creates the lock file holding owner. It reports false if the file already exists.
+func createLockFile(lockPath, owner string) (bool, error) {
+	f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create lock file: %w", err)
+	}
+
+	if _, err := f.WriteString(owner); err != nil {
+		f.Close()
+		_ = os.Remove(lockPath)
+		return false, fmt.Errorf("failed to write lock file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(lockPath)
+		return false, fmt.Errorf("failed to write lock file: %w", err)
+	}
+	return true, nil
+}
+
+// readLockFile returns the owner recorded in the lock file and its file info
+func readLockFile(lockPath string) (string, os.FileInfo, error) {
+	info, err := os.Stat(lockPath)
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := os.ReadFile(lockPath)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(data), info, nil
+}
+
+// releaseLockFile removes the lock file if it still belongs to owner. The file is first moved
+// aside atomically, so a lock created concurrently by another process is never deleted; if
+// the moved file turns out to belong to someone else it is put back. by names the process
+// releasing the lock, keeping the temporary name unique.
+func releaseLockFile(lockPath, owner, by string) {
+	aside := lockPath + "." + by + ".release"
+	if err := os.Rename(lockPath, aside); err != nil {
+		return
+	}
+
+	if current, err := os.ReadFile(aside); err != nil || string(current) != owner {
+		// Link fails rather than replacing a lock taken since the file was moved aside
+		_ = os.Link(aside, lockPath)
+	}
+	_ = os.Remove(aside)
+}
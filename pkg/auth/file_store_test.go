@@ -0,0 +1,219 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFileTokenStore_SaveLoadClear(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "nested", "token.json")
+	store := NewFileTokenStore(path)
+
+	token, err := store.Load(ctx)
+	if err != nil || token != nil {
+		t.Fatalf("Load() on missing file = %v, %v; want nil, nil", token, err)
+	}
+
+	want := &Token{
+		AccessToken:      "access",
+		RefreshToken:     "refresh",
+		ExpiresAt:        time.Now().Add(time.Hour).Truncate(time.Second),
+		RefreshExpiresAt: time.Now().Add(24 * time.Hour).Truncate(time.Second),
+	}
+	if err := store.Save(ctx, want); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat token file: %v", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0o600 {
+		t.Errorf("token file permissions = %o, want 600", info.Mode().Perm())
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("token directory has %d entries, want only the token file", len(entries))
+	}
+
+	got, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if got.AccessToken != want.AccessToken || got.RefreshToken != want.RefreshToken ||
+		!got.ExpiresAt.Equal(want.ExpiresAt) || !got.RefreshExpiresAt.Equal(want.RefreshExpiresAt) {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+
+	// Zero expiries are left out of the file rather than written as year 1
+	if err := store.Save(ctx, &Token{AccessToken: "opaque"}); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != `{"accessToken":"opaque"}` {
+		t.Errorf("token file = %s, want only the access token", data)
+	}
+
+	if err := store.Clear(ctx); err != nil {
+		t.Fatalf("Clear() error: %v", err)
+	}
+	if err := store.Clear(ctx); err != nil {
+		t.Errorf("Clear() on missing file error: %v", err)
+	}
+	if token, _ := store.Load(ctx); token != nil {
+		t.Errorf("Load() after Clear() = %+v, want nil", token)
+	}
+}
+
+func TestFileTokenStore_Lock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	store := NewFileTokenStore(path, WithFileLocking(time.Minute))
+
+	unlock, err := store.Lock(context.Background())
+	if err != nil {
+		t.Fatalf("Lock() error: %v", err)
+	}
+
+	// A second holder waits until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := NewFileTokenStore(path, WithFileLocking(time.Minute)).Lock(ctx); err == nil {
+		t.Fatal("second Lock() succeeded while the lock was held")
+	}
+
+	unlock()
+
+	unlock, err = store.Lock(context.Background())
+	if err != nil {
+		t.Fatalf("Lock() after unlock error: %v", err)
+	}
+	unlock()
+}
+
+func TestFileTokenStore_LockBreaksStaleLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	if err := os.WriteFile(path+".lock", nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path+".lock", old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	unlock, err := NewFileTokenStore(path, WithFileLocking(time.Minute)).Lock(ctx)
+	if err != nil {
+		t.Fatalf("Lock() with stale lock file error: %v", err)
+	}
+	unlock()
+}
+
+func TestFileTokenStore_LockOutlivingStaleAfter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	newStore := func() *FileTokenStore { return NewFileTokenStore(path, WithFileLocking(100*time.Millisecond)) }
+
+	unlock, err := newStore().Lock(context.Background())
+	if err != nil {
+		t.Fatalf("Lock() error: %v", err)
+	}
+
+	// The holder keeps the lock fresh, so a waiter cannot break it however long it is held
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if _, err := newStore().Lock(ctx); err == nil {
+		t.Fatal("second Lock() broke a lock that was still held")
+	}
+
+	unlock()
+	unlock()
+
+	unlock, err = newStore().Lock(context.Background())
+	if err != nil {
+		t.Fatalf("Lock() after unlock error: %v", err)
+	}
+	unlock()
+}
+
+func TestFileTokenStore_LockIsExclusive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	var holders, maxHolders atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// Each holder keeps the lock for longer than staleAfter
+			unlock, err := NewFileTokenStore(path, WithFileLocking(50*time.Millisecond)).Lock(context.Background())
+			if err != nil {
+				t.Errorf("Lock() error: %v", err)
+				return
+			}
+			n := holders.Add(1)
+			for current := maxHolders.Load(); n > current && !maxHolders.CompareAndSwap(current, n); current = maxHolders.Load() {
+			}
+			time.Sleep(120 * time.Millisecond)
+			holders.Add(-1)
+			unlock()
+		}()
+	}
+	wg.Wait()
+
+	if got := maxHolders.Load(); got != 1 {
+		t.Errorf("up to %d processes held the lock at once, want 1", got)
+	}
+}
+
+func TestFileTokenStore_UnlockKeepsOtherOwnersLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	store := NewFileTokenStore(path, WithFileLocking(time.Minute))
+
+	unlock, err := store.Lock(context.Background())
+	if err != nil {
+		t.Fatalf("Lock() error: %v", err)
+	}
+
+	// Another process broke the lock while this one was stalled and now holds it
+	if err := os.WriteFile(path+".lock", []byte("other-owner"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+
+	data, err := os.ReadFile(path + ".lock")
+	if err != nil || string(data) != "other-owner" {
+		t.Fatalf("lock file after unlock = %q, %v; want the other owner's lock kept", data, err)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("token directory has %d entries, want only the lock file", len(entries))
+	}
+}
+
+func TestMemoryTokenStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTokenStore()
+
+	token := &Token{AccessToken: "access"}
+	_ = store.Save(ctx, token)
+	token.AccessToken = "mutated"
+
+	got, _ := store.Load(ctx)
+	if got == nil || got.AccessToken != "access" {
+		t.Fatalf("Load() = %+v, want the saved copy", got)
+	}
+
+	_ = store.Clear(ctx)
+	if got, _ := store.Load(ctx); got != nil {
+		t.Errorf("Load() after Clear() = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,29 @@
+package auth
+
+import "time"
+
+// Session describes the client's current authentication state
+type Session struct {
+	Access           *Claims   // claims of the access token; nil if it is not a JWT
+	Refresh          *Claims   // claims of the refresh token; nil if there is none or it is not a JWT
+	ExpiresAt        time.Time // when the access token expires; zero if unknown
+	RefreshExpiresAt time.Time // when the refresh token expires; zero if unknown
+}
+
+// Subject returns the subject of the access token, or an empty string if unknown
+func (s *Session) Subject() string {
+	if s == nil || s.Access == nil {
+		return ""
+	}
+	return s.Access.Subject
+}
+
+// HasScope reports whether the access token grants scope
+func (s *Session) HasScope(scope string) bool {
+	return s != nil && s.Access.HasScope(scope)
+}
+
+// HasRole reports whether the access token includes role
+func (s *Session) HasRole(role string) bool {
+	return s != nil && s.Access.HasRole(role)
+}
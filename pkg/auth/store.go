@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"sync"
+)
+
+// TokenStore persists tokens between client instances so that a restarted process can reuse
+// or refresh its previous token instead of logging in again. Implementations must be safe
+// for concurrent use.
+type TokenStore interface {
+	// Load returns the stored token, or nil and no error if nothing is stored
+	Load(ctx context.Context) (*Token, error)
+	// Save replaces the stored token
+	Save(ctx context.Context, token *Token) error
+	// Clear removes the stored token
+	Clear(ctx context.Context) error
+}
+
+// Locker is implemented by token stores shared between processes. The client holds the lock
+// while it obtains a new token, so only one process logs in or refreshes at a time and the
+// others pick up its result from the store.
+type Locker interface {
+	// Lock blocks until the lock is acquired or ctx is done, and returns a function releasing it
+	Lock(ctx context.Context) (unlock func(), err error)
+}
+
+// MemoryTokenStore keeps a token in memory. It lets several clients in one process share a token.
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *Token
+}
+
+var _ TokenStore = (*MemoryTokenStore)(nil)
+
+// NewMemoryTokenStore creates an empty MemoryTokenStore
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{}
+}
+
+// Load implements TokenStore
+func (s *MemoryTokenStore) Load(context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil, nil
+	}
+	token := *s.token
+	return &token, nil
+}
+
+// Save implements TokenStore
+func (s *MemoryTokenStore) Save(_ context.Context, token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if token == nil {
+		s.token = nil
+		return nil
+	}
+	stored := *token
+	s.token = &stored
+	return nil
+}
+
+// Clear implements TokenStore
+func (s *MemoryTokenStore) Clear(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = nil
+	return nil
+}
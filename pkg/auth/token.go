@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Token is an access token issued by the IAM service, optionally with a refresh token
+type Token struct {
+	AccessToken      string    `json:"accessToken"`
+	RefreshToken     string    `json:"refreshToken,omitempty"`
+	ExpiresAt        time.Time `json:"expiresAt,omitzero"`        // zero means read from the JWT, or never expires
+	RefreshExpiresAt time.Time `json:"refreshExpiresAt,omitzero"` // zero means read from the JWT
+}
+
+// TokenSource supplies access tokens to the client. It is consulted whenever the client has
+// no usable token and cannot refresh the one it has.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenSourceFunc adapts an ordinary function to the TokenSource interface
+type TokenSourceFunc func(ctx context.Context) (*Token, error)
+
+// Token calls f(ctx)
+func (f TokenSourceFunc) Token(ctx context.Context) (*Token, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns a TokenSource that always supplies the given pre-issued bearer token.
+// If the token is a JWT its expiry is honoured; otherwise it is used until the server rejects it.
+func StaticTokenSource(accessToken string) TokenSource {
+	return TokenSourceFunc(func(context.Context) (*Token, error) {
+		if accessToken == "" {
+			return nil, fmt.Errorf("static access token is empty")
+		}
+		return &Token{AccessToken: accessToken}, nil
+	})
+}
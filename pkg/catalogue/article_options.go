@@ -0,0 +1,31 @@
+package catalogue
+
+// ArticleSort is the order of an article listing
+type ArticleSort string
+
+const (
+	ArticleSortPublishedDesc ArticleSort = "-publishedAt"
+	ArticleSortUpdatedDesc   ArticleSort = "-updatedAt"
+	ArticleSortTitle         ArticleSort = "title"
+)
+
+// ArticleListOptions filters and pages article listings. Zero values are not sent.
+type ArticleListOptions struct {
+	Status   string      `url:"status"`   // e.g. "published"
+	EntityID string      `url:"entityId"` // only articles tagged with this work or person
+	Sort     ArticleSort `url:"sort"`
+	Limit    int         `url:"limit"`  // page size; the server applies its default when zero
+	Cursor   string      `url:"cursor"` // Page.NextCursor of the previous page
+}
+
+// withCursor returns a copy of opts positioned at cursor, or at opts.Cursor if cursor is empty
+func (opts *ArticleListOptions) withCursor(cursor string) *ArticleListOptions {
+	var o ArticleListOptions
+	if opts != nil {
+		o = *opts
+	}
+	if cursor != "" {
+		o.Cursor = cursor
+	}
+	return &o
+}
@@ -3,6 +3,7 @@ package catalogue
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
 )
@@ -14,7 +15,10 @@ func NewArticleService(httpClient httpclient.Client) ArticleService {
 }
 
 // GetByIdentifier retrieves an article by its identifier
-func (a *ArticleSvc) GetByIdentifier(ctx context.Context, identifier string) (*Article, error) {
+func (a *ArticleSvc) GetByIdentifier(ctx context.Context, identifier string) (_ *Article, err error) {
+	ctx, span := a.httpClient.StartSpan(ctx, "ArticleService.GetByIdentifier")
+	defer func() { httpclient.EndSpan(span, err) }()
+
 	if identifier == "" {
 		return nil, fmt.Errorf("identifier cannot be empty")
 	}
@@ -22,10 +26,33 @@ func (a *ArticleSvc) GetByIdentifier(ctx context.Context, identifier string) (*A
 	url := fmt.Sprintf("%s/articles/%s", a.httpClient.GetCatalogueBaseURL(), identifier)
 	var article Article
 
-	err := a.httpClient.Get(ctx, url, nil, &article)
+	err = a.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/articles/{identifier}"), url, nil, &article)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get article: %w", err)
 	}
 
 	return &article, nil
 }
+
+// List retrieves a page of articles matching the filters in opts
+func (a *ArticleSvc) List(ctx context.Context, opts *ArticleListOptions) (_ *Page[Article], err error) {
+	ctx, span := a.httpClient.StartSpan(ctx, "ArticleService.List")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	url := withQuery(fmt.Sprintf("%s/articles", a.httpClient.GetCatalogueBaseURL()), opts)
+	var page Page[Article]
+
+	err = a.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/articles"), url, nil, &page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles: %w", err)
+	}
+
+	return &page, nil
+}
+
+// ListAll iterates over every article matching opts, fetching pages as the loop advances
+func (a *ArticleSvc) ListAll(ctx context.Context, opts *ArticleListOptions, iterOpts ...IterOption) iter.Seq2[*Article, error] {
+	return Iterate(ctx, func(ctx context.Context, cursor string) (*Page[Article], error) {
+		return a.List(ctx, opts.withCursor(cursor))
+	}, iterOpts...)
+}
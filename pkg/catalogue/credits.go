@@ -0,0 +1,157 @@
+package catalogue
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+)
+
+// CreditType distinguishes on-screen from off-screen credits
+type CreditType string
+
+const (
+	CreditTypeCast CreditType = "cast"
+	CreditTypeCrew CreditType = "crew"
+)
+
+// Credit is a person's involvement in a work
+type Credit struct {
+	ID           string     `json:"id"`
+	WorkID       string     `json:"workId"`
+	PersonID     string     `json:"personId"`
+	CreditType   CreditType `json:"creditType"`
+	Department   *string    `json:"department"`   // crew department, e.g. "Directing"
+	Job          *string    `json:"job"`          // crew job or role, e.g. "Director"
+	Character    *string    `json:"character"`    // cast character name
+	BillingOrder *int       `json:"billingOrder"` // cast position in the billing, lowest first
+	Work         *Work      `json:"work"`         // embedded by the server or joined by the SDK; nil if unknown
+	Person       *Person    `json:"person"`       // embedded by the server or joined by the SDK; nil if unknown
+}
+
+// batchSize is the largest number of identifiers sent in one batch request
+const batchSize = 100
+
+// getBatched fetches items by identifier in batches of at most batchSize, skipping duplicates
+func getBatched[T any](ctx context.Context, identifiers []string, get func(context.Context, []string) ([]*T, error)) ([]*T, error) {
+	unique := slices.Compact(slices.Sorted(slices.Values(identifiers)))
+
+	var items []*T
+	for batch := range slices.Chunk(unique, batchSize) {
+		got, err := get(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, got...)
+	}
+	return items, nil
+}
+
+// WorkCredits are the cast and crew of a work
+type WorkCredits struct {
+	WorkID string
+	Cast   []*Credit // ordered by billing order; unbilled cast last
+	Crew   []*Credit // grouped by department
+}
+
+// CreditsOption configures WorkService.Credits
+type CreditsOption func(*creditsConfig)
+
+type creditsConfig struct {
+	hydratePeople bool
+}
+
+// WithPeople fills in Credit.Person for every credit, fetching the people the server
+// did not embed with batched PeopleService.GetByIdentifiers calls
+func WithPeople() CreditsOption {
+	return func(c *creditsConfig) {
+		c.hydratePeople = true
+	}
+}
+
+// newWorkCredits splits credits into cast and crew and orders them
+func newWorkCredits(workID string, credits []*Credit) *WorkCredits {
+	wc := &WorkCredits{WorkID: workID}
+	for _, credit := range credits {
+		if credit.CreditType == CreditTypeCast {
+			wc.Cast = append(wc.Cast, credit)
+		} else {
+			wc.Crew = append(wc.Crew, credit)
+		}
+	}
+
+	slices.SortStableFunc(wc.Cast, func(a, b *Credit) int {
+		switch {
+		case a.BillingOrder == nil && b.BillingOrder == nil:
+			return 0
+		case a.BillingOrder == nil:
+			return 1
+		case b.BillingOrder == nil:
+			return -1
+		}
+		return cmp.Compare(*a.BillingOrder, *b.BillingOrder)
+	})
+	slices.SortStableFunc(wc.Crew, func(a, b *Credit) int {
+		return cmp.Compare(deref(a.Department), deref(b.Department))
+	})
+
+	return wc
+}
+
+// All returns the cast followed by the crew
+func (wc *WorkCredits) All() []*Credit {
+	return slices.Concat(wc.Cast, wc.Crew)
+}
+
+// PersonIDs returns the distinct identifiers of everyone credited
+func (wc *WorkCredits) PersonIDs() []string {
+	var ids []string
+	for _, credit := range wc.All() {
+		if credit.PersonID != "" && !slices.Contains(ids, credit.PersonID) {
+			ids = append(ids, credit.PersonID)
+		}
+	}
+	return ids
+}
+
+// ResolvePeople fills in Credit.Person for credits the server did not embed, using batched
+// PeopleService.GetByIdentifiers calls. Credits for people that no longer exist keep a nil Person.
+func (wc *WorkCredits) ResolvePeople(ctx context.Context, people PeopleService) error {
+	var missing []string
+	for _, credit := range wc.All() {
+		if credit.Person == nil && credit.PersonID != "" {
+			missing = append(missing, credit.PersonID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	fetched, err := getBatched(ctx, missing, people.GetByIdentifiers)
+	if err != nil {
+		return fmt.Errorf("failed to get people for work credits: %w", err)
+	}
+
+	byID := make(map[string]*Person, len(fetched))
+	for _, person := range fetched {
+		// The batch endpoint returns null for identifiers it does not know
+		if person != nil {
+			byID[person.ID] = person
+		}
+	}
+	for _, credit := range wc.All() {
+		if credit.Person == nil {
+			credit.Person = byID[credit.PersonID]
+		}
+	}
+
+	return nil
+}
+
+// deref returns the value s points to, or an empty string
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,25 @@
+package catalogue
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+// newTestClient returns an httpclient.Client whose catalogue is served by handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) httpclient.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return httpclient.New(&httpclient.Config{
+		CatalogueBaseURL: server.URL,
+		TokenSource:      auth.StaticTokenSource("test-token"),
+		Timeout:          time.Second,
+	})
+}
@@ -0,0 +1,123 @@
+package catalogue
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SeriesTree is a series with its seasons and their episodes
+type SeriesTree struct {
+	Series         *Work
+	Seasons        []*SeasonNode // ordered by season number
+	MissingSeasons []int         // season numbers absent between 1 and the last known season
+	EpisodeCount   int
+	Runtime        int // sum of the episodes' Runtime; episodes without one are not counted
+}
+
+// SeasonNode is a season and its episodes
+type SeasonNode struct {
+	Number          int
+	Season          *Work   // nil when episodes refer to a season the catalogue has no record of
+	Episodes        []*Work // ordered by episode number; unnumbered episodes last
+	MissingEpisodes []int   // episode numbers absent between 1 and the last episode
+	Runtime         int     // sum of the episodes' Runtime; episodes without one are not counted
+}
+
+// buildSeriesTree assembles a tree from a series, its seasons and the episodes of every season,
+// keyed by season number. Episodes attached directly to the series go in the season given
+// by their SeasonNumber.
+func buildSeriesTree(series *Work, seasons []*Work, episodes map[int][]*Work) *SeriesTree {
+	nodes := make(map[int]*SeasonNode)
+	node := func(number int) *SeasonNode {
+		if n, ok := nodes[number]; ok {
+			return n
+		}
+		n := &SeasonNode{Number: number}
+		nodes[number] = n
+		return n
+	}
+
+	for _, season := range seasons {
+		node(intValue(season.SeasonNumber)).Season = season
+	}
+	for number, eps := range episodes {
+		n := node(number)
+		n.Episodes = append(n.Episodes, eps...)
+	}
+
+	tree := &SeriesTree{Series: series}
+	for _, n := range nodes {
+		sortEpisodes(n.Episodes)
+		n.MissingEpisodes = missingNumbers(n.Episodes, func(w *Work) *int { return w.EpisodeNumber }, 0)
+		for _, episode := range n.Episodes {
+			n.Runtime += intValue(episode.Runtime)
+		}
+
+		tree.Seasons = append(tree.Seasons, n)
+		tree.EpisodeCount += len(n.Episodes)
+		tree.Runtime += n.Runtime
+	}
+	slices.SortFunc(tree.Seasons, func(a, b *SeasonNode) int { return cmp.Compare(a.Number, b.Number) })
+
+	numbers := make([]*int, 0, len(tree.Seasons))
+	for _, n := range tree.Seasons {
+		numbers = append(numbers, &n.Number)
+	}
+	tree.MissingSeasons = missingNumbers(numbers, func(n *int) *int { return n }, intValue(series.SeasonCount))
+
+	return tree
+}
+
+// sortEpisodes orders episodes by episode number, unnumbered episodes last
+func sortEpisodes(episodes []*Work) {
+	slices.SortStableFunc(episodes, func(a, b *Work) int {
+		switch {
+		case a.EpisodeNumber == nil && b.EpisodeNumber == nil:
+			return 0
+		case a.EpisodeNumber == nil:
+			return 1
+		case b.EpisodeNumber == nil:
+			return -1
+		}
+		return cmp.Compare(*a.EpisodeNumber, *b.EpisodeNumber)
+	})
+}
+
+// missingNumbers returns the numbers from 1 to the highest present number (or expected, if
+// greater) that no item has
+func missingNumbers[T any](items []T, number func(T) *int, expected int) []int {
+	present := make(map[int]bool)
+	last := expected
+	for _, item := range items {
+		if n := number(item); n != nil && *n > 0 {
+			present[*n] = true
+			last = max(last, *n)
+		}
+	}
+
+	var missing []int
+	for n := 1; n <= last; n++ {
+		if !present[n] {
+			missing = append(missing, n)
+		}
+	}
+	return missing
+}
+
+// intValue returns the value n points to, or zero
+func intValue(n *int) int {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
+
+// isEpisode reports whether w is an episode
+func isEpisode(w *Work) bool {
+	return w.WorkType == WorkTypeEpisode || w.EpisodeNumber != nil
+}
+
+// isSeason reports whether w is a season
+func isSeason(w *Work) bool {
+	return !isEpisode(w) && (w.WorkType == WorkTypeSeason || w.SeasonNumber != nil)
+}
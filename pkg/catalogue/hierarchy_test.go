@@ -0,0 +1,120 @@
+package catalogue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// seriesHandler serves a series with seasons 3 and 1, one episode attached directly to the
+// series for season 2, and a SeasonCount of 4
+func seriesHandler(w http.ResponseWriter, r *http.Request) {
+	responses := map[string]string{
+		"/works/show": `{"id":"show","workType":"series","title":"Shanty Town","seasonCount":4}`,
+		"/works/show/children": `[
+			{"id":"s3","workType":"season","seasonNumber":3},
+			{"id":"s1","workType":"season","seasonNumber":1},
+			{"id":"s2e1","workType":"episode","seasonNumber":2,"episodeNumber":1,"runtime":50}
+		]`,
+		"/works/s1/children": `[
+			{"id":"s1e3","workType":"episode","seasonNumber":1,"episodeNumber":3,"runtime":45},
+			{"id":"s1-special","workType":"episode","seasonNumber":1},
+			{"id":"s1e1","workType":"episode","seasonNumber":1,"episodeNumber":1,"runtime":40}
+		]`,
+		"/works/s3/children": `[
+			{"id":"s3e2","workType":"episode","seasonNumber":3,"episodeNumber":2,"runtime":30},
+			{"id":"s3e1","workType":"episode","seasonNumber":3,"episodeNumber":1}
+		]`,
+	}
+
+	body, ok := responses[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write([]byte(body))
+}
+
+func ids(works []*Work) []string {
+	var result []string
+	for _, work := range works {
+		result = append(result, work.ID)
+	}
+	return result
+}
+
+func TestWorkSvc_Hierarchy(t *testing.T) {
+	svc := NewWorkService(newTestClient(t, seriesHandler))
+
+	tree, err := svc.Hierarchy(context.Background(), "show")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tree.Series.Title != "Shanty Town" || tree.EpisodeCount != 6 || tree.Runtime != 165 {
+		t.Errorf("series = %q, %d episodes, runtime %d; want Shanty Town, 6, 165", tree.Series.Title, tree.EpisodeCount, tree.Runtime)
+	}
+	if !slices.Equal(tree.MissingSeasons, []int{4}) {
+		t.Errorf("MissingSeasons = %v, want [4]", tree.MissingSeasons)
+	}
+
+	want := []struct {
+		number   int
+		seasonID string
+		episodes []string
+		missing  []int
+		runtime  int
+	}{
+		{number: 1, seasonID: "s1", episodes: []string{"s1e1", "s1e3", "s1-special"}, missing: []int{2}, runtime: 85},
+		{number: 2, episodes: []string{"s2e1"}, runtime: 50},
+		{number: 3, seasonID: "s3", episodes: []string{"s3e1", "s3e2"}, runtime: 30},
+	}
+	if len(tree.Seasons) != len(want) {
+		t.Fatalf("got %d seasons, want %d", len(tree.Seasons), len(want))
+	}
+	for i, w := range want {
+		season := tree.Seasons[i]
+		seasonID := ""
+		if season.Season != nil {
+			seasonID = season.Season.ID
+		}
+		got := fmt.Sprint(season.Number, seasonID, ids(season.Episodes), season.MissingEpisodes, season.Runtime)
+		if exp := fmt.Sprint(w.number, w.seasonID, w.episodes, w.missing, w.runtime); got != exp {
+			t.Errorf("season %d = %s, want %s", i, got, exp)
+		}
+	}
+}
+
+func TestWorkSvc_SeasonsAndEpisodes(t *testing.T) {
+	svc := NewWorkService(newTestClient(t, seriesHandler))
+	ctx := context.Background()
+
+	seasons, err := svc.Seasons(ctx, "show")
+	if err != nil {
+		t.Fatalf("Seasons() error: %v", err)
+	}
+	if got := ids(seasons); !slices.Equal(got, []string{"s1", "s3"}) {
+		t.Errorf("Seasons() = %v, want [s1 s3]", got)
+	}
+
+	tests := []struct {
+		season  int
+		want    []string
+		wantErr bool
+	}{
+		{season: 1, want: []string{"s1e1", "s1e3", "s1-special"}},
+		{season: 2, want: []string{"s2e1"}},
+		{season: 5, wantErr: true},
+	}
+	for _, tt := range tests {
+		episodes, err := svc.Episodes(ctx, "show", tt.season)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Episodes(%d) error = %v, wantErr %v", tt.season, err, tt.wantErr)
+		}
+		if got := ids(episodes); !slices.Equal(got, tt.want) {
+			t.Errorf("Episodes(%d) = %v, want %v", tt.season, got, tt.want)
+		}
+	}
+}
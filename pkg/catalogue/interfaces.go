@@ -1,17 +1,42 @@
 package catalogue
 
-import "context"
+import (
+	"context"
+	"iter"
+)
 
 type WorkService interface {
 	// GetByIdentifier retrieves a work by its identifier
 	GetByIdentifier(ctx context.Context, identifier string) (*Work, error)
 	// GetByIdentifiers retrieves multiple works by their identifiers
 	GetByIdentifiers(ctx context.Context, identifiers []string) ([]*Work, error)
+	// List retrieves a page of works matching the filters in opts
+	List(ctx context.Context, opts *WorkListOptions) (*Page[Work], error)
+	// Search retrieves a page of works matching opts.Query and the other filters in opts
+	Search(ctx context.Context, opts *WorkListOptions) (*Page[Work], error)
+	// ListAll iterates over every work matching opts, fetching pages as the loop advances
+	ListAll(ctx context.Context, opts *WorkListOptions, iterOpts ...IterOption) iter.Seq2[*Work, error]
+	// SearchAll iterates over every work matching opts.Query, fetching pages as the loop advances
+	SearchAll(ctx context.Context, opts *WorkListOptions, iterOpts ...IterOption) iter.Seq2[*Work, error]
+	// Credits retrieves the cast and crew of a work
+	Credits(ctx context.Context, workID string, opts ...CreditsOption) (*WorkCredits, error)
+	// Children retrieves the works directly below a work, e.g. the seasons of a series
+	Children(ctx context.Context, identifier string) ([]*Work, error)
+	// Seasons retrieves the seasons of a series, ordered by season number
+	Seasons(ctx context.Context, seriesID string) ([]*Work, error)
+	// Episodes retrieves the episodes of one season of a series, ordered by episode number
+	Episodes(ctx context.Context, seriesID string, season int) ([]*Work, error)
+	// Hierarchy retrieves a series with all its seasons and episodes as a tree
+	Hierarchy(ctx context.Context, seriesID string) (*SeriesTree, error)
 }
 
 type ArticleService interface {
 	// GetByIdentifier retrieves an article by its identifier
 	GetByIdentifier(ctx context.Context, identifier string) (*Article, error)
+	// List retrieves a page of articles matching the filters in opts
+	List(ctx context.Context, opts *ArticleListOptions) (*Page[Article], error)
+	// ListAll iterates over every article matching opts, fetching pages as the loop advances
+	ListAll(ctx context.Context, opts *ArticleListOptions, iterOpts ...IterOption) iter.Seq2[*Article, error]
 }
 
 type PeopleService interface {
@@ -19,4 +44,14 @@ type PeopleService interface {
 	GetByIdentifier(ctx context.Context, identifier string) (*Person, error)
 	// GetByIdentifiers retrieves multiple people by their identifiers
 	GetByIdentifiers(ctx context.Context, identifiers []string) ([]*Person, error)
+	// List retrieves a page of people matching the filters in opts
+	List(ctx context.Context, opts *PersonListOptions) (*Page[Person], error)
+	// ListAll iterates over every person matching opts, fetching pages as the loop advances
+	ListAll(ctx context.Context, opts *PersonListOptions, iterOpts ...IterOption) iter.Seq2[*Person, error]
+	// Search retrieves a page of people whose name or alias matches opts.Query and the other filters in opts
+	Search(ctx context.Context, opts *PersonListOptions) (*Page[Person], error)
+	// SearchAll iterates over every person matching opts.Query, fetching pages as the loop advances
+	SearchAll(ctx context.Context, opts *PersonListOptions, iterOpts ...IterOption) iter.Seq2[*Person, error]
+	// Credits retrieves a person's credits, each joined with its work
+	Credits(ctx context.Context, personID string) ([]*Credit, error)
 }
@@ -0,0 +1,124 @@
+package catalogue
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// PageFetcher fetches the page of a listing that starts at cursor; the first page has an empty cursor
+type PageFetcher[T any] func(ctx context.Context, cursor string) (*Page[T], error)
+
+// IterOption configures an iterator returned by Iterate or a service's ListAll/SearchAll
+type IterOption func(*iterConfig)
+
+type iterConfig struct {
+	maxItems int
+	prefetch bool
+}
+
+// WithMaxItems stops the iteration after n items. Zero or less means no limit.
+func WithMaxItems(n int) IterOption {
+	return func(c *iterConfig) {
+		c.maxItems = n
+	}
+}
+
+// WithPrefetch fetches the next page in the background while the current one is consumed
+func WithPrefetch() IterOption {
+	return func(c *iterConfig) {
+		c.prefetch = true
+	}
+}
+
+// pageResult is the outcome of a prefetched page
+type pageResult[T any] struct {
+	page *Page[T]
+	err  error
+}
+
+// Iterate returns an iterator over every item of a paginated listing. Pages are fetched lazily
+// as the loop advances; breaking out of the loop stops fetching. A failed fetch or a cancelled
+// ctx is yielded as a final (nil, err) pair.
+func Iterate[T any](ctx context.Context, fetch PageFetcher[T], opts ...IterOption) iter.Seq2[*T, error] {
+	var cfg iterConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(*T, error) bool) {
+		// Cancelling stops any prefetch still in flight once the loop ends
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		limitReached := func(n int) bool { return cfg.maxItems > 0 && n >= cfg.maxItems }
+
+		var cursor string
+		var pending chan pageResult[T]
+		yielded := 0
+
+		for {
+			var page *Page[T]
+			var err error
+			if pending != nil {
+				result := <-pending
+				page, err, pending = result.page, result.err, nil
+			} else if err = ctx.Err(); err == nil {
+				page, err = fetch(ctx, cursor)
+			}
+			if err == nil && page == nil {
+				err = fmt.Errorf("listing returned no page")
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			next := page.NextCursor
+			if next != "" && next == cursor {
+				yield(nil, fmt.Errorf("pagination cursor %q did not advance", next))
+				return
+			}
+
+			if next != "" && cfg.prefetch && !limitReached(yielded+len(page.Items)) {
+				pending = make(chan pageResult[T], 1)
+				go func(ch chan<- pageResult[T], cursor string) {
+					page, err := fetch(ctx, cursor)
+					ch <- pageResult[T]{page: page, err: err}
+				}(pending, next)
+			}
+
+			for _, item := range page.Items {
+				if limitReached(yielded) {
+					return
+				}
+				if err := ctx.Err(); err != nil {
+					yield(nil, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+			}
+
+			if next == "" || limitReached(yielded) {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// Collect gathers every item of seq into a slice. On error it returns the items collected
+// so far along with the error. Use it with WithMaxItems or for listings known to be small.
+func Collect[T any](seq iter.Seq2[*T, error]) ([]*T, error) {
+	var items []*T
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
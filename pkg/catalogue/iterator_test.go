@@ -0,0 +1,153 @@
+package catalogue
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// pagedFetcher serves ids 1..total in pages of size, with cursors holding the next offset
+func pagedFetcher(total, size int, calls *atomic.Int32) PageFetcher[Work] {
+	return func(ctx context.Context, cursor string) (*Page[Work], error) {
+		calls.Add(1)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		offset, _ := strconv.Atoi(cursor)
+		page := &Page[Work]{Total: total}
+		for i := offset; i < min(offset+size, total); i++ {
+			page.Items = append(page.Items, &Work{ID: strconv.Itoa(i + 1)})
+		}
+		if offset+size < total {
+			page.NextCursor = strconv.Itoa(offset + size)
+		}
+		return page, nil
+	}
+}
+
+func TestIterate(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []IterOption
+		breakAt   int // stop the loop after this many items; 0 to run to completion
+		wantItems int
+		maxCalls  int32
+	}{
+		{name: "all pages", wantItems: 7, maxCalls: 3},
+		{name: "max items within first page", opts: []IterOption{WithMaxItems(2)}, wantItems: 2, maxCalls: 1},
+		{name: "max items across pages", opts: []IterOption{WithMaxItems(4)}, wantItems: 4, maxCalls: 2},
+		{name: "break stops fetching", breakAt: 3, wantItems: 3, maxCalls: 1},
+		{name: "prefetch", opts: []IterOption{WithPrefetch()}, wantItems: 7, maxCalls: 3},
+		{name: "prefetch respects max items", opts: []IterOption{WithPrefetch(), WithMaxItems(3)}, wantItems: 3, maxCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			var ids []string
+
+			for work, err := range Iterate(context.Background(), pagedFetcher(7, 3, &calls), tt.opts...) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				ids = append(ids, work.ID)
+				if len(ids) == tt.breakAt {
+					break
+				}
+			}
+
+			if len(ids) != tt.wantItems {
+				t.Fatalf("got %d items %v, want %d", len(ids), ids, tt.wantItems)
+			}
+			for i, id := range ids {
+				if id != strconv.Itoa(i+1) {
+					t.Fatalf("items = %v, want 1..%d in order", ids, tt.wantItems)
+				}
+			}
+			if got := calls.Load(); got > tt.maxCalls {
+				t.Errorf("fetched %d pages, want at most %d", got, tt.maxCalls)
+			}
+		})
+	}
+}
+
+func TestIterate_Errors(t *testing.T) {
+	t.Run("fetch error", func(t *testing.T) {
+		boom := errors.New("boom")
+		var calls atomic.Int32
+		fetch := func(ctx context.Context, cursor string) (*Page[Work], error) {
+			if cursor != "" {
+				return nil, boom
+			}
+			return pagedFetcher(7, 3, &calls)(ctx, cursor)
+		}
+
+		items, err := Collect(Iterate(context.Background(), fetch))
+		if !errors.Is(err, boom) || len(items) != 3 {
+			t.Errorf("Collect() = %d items, %v; want 3 items and boom", len(items), err)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var calls atomic.Int32
+
+		var n int
+		var gotErr error
+		for _, err := range Iterate(ctx, pagedFetcher(7, 3, &calls), WithPrefetch()) {
+			if err != nil {
+				gotErr = err
+				break
+			}
+			if n++; n == 2 {
+				cancel()
+			}
+		}
+
+		if !errors.Is(gotErr, context.Canceled) || n != 2 {
+			t.Errorf("stopped after %d items with %v, want 2 items and context.Canceled", n, gotErr)
+		}
+	})
+
+	t.Run("cursor does not advance", func(t *testing.T) {
+		fetch := func(ctx context.Context, cursor string) (*Page[Work], error) {
+			return &Page[Work]{Items: []*Work{{ID: "1"}}, NextCursor: "same"}, nil
+		}
+
+		items, err := Collect(Iterate(context.Background(), fetch))
+		if err == nil || len(items) != 1 {
+			t.Errorf("Collect() = %d items, %v; want 1 item and an error", len(items), err)
+		}
+	})
+}
+
+func TestWorkSvc_ListAll(t *testing.T) {
+	var requests atomic.Int32
+	svc := NewWorkService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Query().Get("workType") != WorkTypeSeries {
+			t.Errorf("filters were not sent: %q", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			_, _ = w.Write([]byte(`{"items":[{"id":"w1"},{"id":"w2"}],"total":3,"nextCursor":"p2"}`))
+		case "p2":
+			_, _ = w.Write([]byte(`{"items":[{"id":"w3"}],"total":3}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	works, err := Collect(svc.ListAll(context.Background(), &WorkListOptions{WorkType: WorkTypeSeries}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(works) != 3 || works[2].ID != "w3" || requests.Load() != 2 {
+		t.Errorf("got %d works in %d requests", len(works), requests.Load())
+	}
+}
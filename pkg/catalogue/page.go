@@ -0,0 +1,23 @@
+package catalogue
+
+import "github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+
+// Page is one page of a listing. Pass NextCursor as the Cursor option to fetch the next page.
+type Page[T any] struct {
+	Items      []*T   `json:"items"`
+	Total      int    `json:"total"`      // number of matching items across all pages
+	NextCursor string `json:"nextCursor"` // empty on the last page
+}
+
+// HasMore reports whether there is a page after this one
+func (p *Page[T]) HasMore() bool {
+	return p != nil && p.NextCursor != ""
+}
+
+// withQuery appends the query parameters encoded from opts to url
+func withQuery(url string, opts any) string {
+	if query := httpclient.StructToQueryParams(opts); query != "" {
+		return url + "?" + query
+	}
+	return url
+}
@@ -0,0 +1,34 @@
+package catalogue
+
+// PersonSort is the order of a people listing
+type PersonSort string
+
+const (
+	PersonSortRelevance   PersonSort = "relevance" // best match first; the default for searches
+	PersonSortName        PersonSort = "name"
+	PersonSortUpdatedDesc PersonSort = "-updatedAt"
+)
+
+// PersonListOptions filters and pages people listings. Zero values are not sent.
+type PersonListOptions struct {
+	Query       string     `url:"q"` // matches names and aliases; required by Search
+	Gender      string     `url:"gender"`
+	Nationality string     `url:"nationality"`
+	Deceased    *bool      `url:"deceased"`
+	Featured    *bool      `url:"featured"`
+	Sort        PersonSort `url:"sort"`
+	Limit       int        `url:"limit"`  // page size; the server applies its default when zero
+	Cursor      string     `url:"cursor"` // Page.NextCursor of the previous page
+}
+
+// withCursor returns a copy of opts positioned at cursor, or at opts.Cursor if cursor is empty
+func (opts *PersonListOptions) withCursor(cursor string) *PersonListOptions {
+	var o PersonListOptions
+	if opts != nil {
+		o = *opts
+	}
+	if cursor != "" {
+		o.Cursor = cursor
+	}
+	return &o
+}
@@ -3,6 +3,7 @@ package catalogue
 import (
 	"context"
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
@@ -15,7 +16,10 @@ func NewPeopleService(httpClient httpclient.Client) PeopleService {
 }
 
 // GetByIdentifier retrieves a person by their identifier
-func (p *PeopleSvc) GetByIdentifier(ctx context.Context, identifier string) (*Person, error) {
+func (p *PeopleSvc) GetByIdentifier(ctx context.Context, identifier string) (_ *Person, err error) {
+	ctx, span := p.httpClient.StartSpan(ctx, "PeopleService.GetByIdentifier")
+	defer func() { httpclient.EndSpan(span, err) }()
+
 	if identifier == "" {
 		return nil, fmt.Errorf("identifier cannot be empty")
 	}
@@ -23,7 +27,7 @@ func (p *PeopleSvc) GetByIdentifier(ctx context.Context, identifier string) (*Pe
 	url := fmt.Sprintf("%s/people/%s", p.httpClient.GetCatalogueBaseURL(), identifier)
 	var person Person
 
-	err := p.httpClient.Get(ctx, url, nil, &person)
+	err = p.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/people/{identifier}"), url, nil, &person)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get person: %w", err)
 	}
@@ -32,7 +36,10 @@ func (p *PeopleSvc) GetByIdentifier(ctx context.Context, identifier string) (*Pe
 }
 
 // GetByIdentifiers retrieves multiple people by their identifiers
-func (p *PeopleSvc) GetByIdentifiers(ctx context.Context, identifiers []string) ([]*Person, error) {
+func (p *PeopleSvc) GetByIdentifiers(ctx context.Context, identifiers []string) (_ []*Person, err error) {
+	ctx, span := p.httpClient.StartSpan(ctx, "PeopleService.GetByIdentifiers")
+	defer func() { httpclient.EndSpan(span, err) }()
+
 	if len(identifiers) == 0 {
 		return nil, fmt.Errorf("identifiers cannot be empty")
 	}
@@ -44,10 +51,110 @@ func (p *PeopleSvc) GetByIdentifiers(ctx context.Context, identifiers []string)
 
 	var people []*Person
 
-	err := p.httpClient.Get(ctx, url, params, &people)
+	err = p.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/people/batch"), url, params, &people)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get people: %w", err)
 	}
 
 	return people, nil
 }
+
+// List retrieves a page of people matching the filters in opts
+func (p *PeopleSvc) List(ctx context.Context, opts *PersonListOptions) (_ *Page[Person], err error) {
+	ctx, span := p.httpClient.StartSpan(ctx, "PeopleService.List")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	url := withQuery(fmt.Sprintf("%s/people", p.httpClient.GetCatalogueBaseURL()), opts)
+	var page Page[Person]
+
+	err = p.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/people"), url, nil, &page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list people: %w", err)
+	}
+
+	return &page, nil
+}
+
+// ListAll iterates over every person matching opts, fetching pages as the loop advances
+func (p *PeopleSvc) ListAll(ctx context.Context, opts *PersonListOptions, iterOpts ...IterOption) iter.Seq2[*Person, error] {
+	return Iterate(ctx, func(ctx context.Context, cursor string) (*Page[Person], error) {
+		return p.List(ctx, opts.withCursor(cursor))
+	}, iterOpts...)
+}
+
+// Search retrieves a page of people whose name or alias matches opts.Query and the other filters in opts
+func (p *PeopleSvc) Search(ctx context.Context, opts *PersonListOptions) (_ *Page[Person], err error) {
+	ctx, span := p.httpClient.StartSpan(ctx, "PeopleService.Search")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if opts == nil || strings.TrimSpace(opts.Query) == "" {
+		return nil, fmt.Errorf("query cannot be empty")
+	}
+
+	url := withQuery(fmt.Sprintf("%s/people/search", p.httpClient.GetCatalogueBaseURL()), opts)
+	var page Page[Person]
+
+	err = p.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/people/search"), url, nil, &page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search people: %w", err)
+	}
+
+	return &page, nil
+}
+
+// SearchAll iterates over every person matching opts.Query, fetching pages as the loop advances
+func (p *PeopleSvc) SearchAll(ctx context.Context, opts *PersonListOptions, iterOpts ...IterOption) iter.Seq2[*Person, error] {
+	return Iterate(ctx, func(ctx context.Context, cursor string) (*Page[Person], error) {
+		return p.Search(ctx, opts.withCursor(cursor))
+	}, iterOpts...)
+}
+
+// Credits retrieves a person's credits. Works the server does not embed are fetched
+// with batched GetByIdentifiers calls rather than one request per credit.
+func (p *PeopleSvc) Credits(ctx context.Context, personID string) (_ []*Credit, err error) {
+	ctx, span := p.httpClient.StartSpan(ctx, "PeopleService.Credits")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if personID == "" {
+		return nil, fmt.Errorf("personID cannot be empty")
+	}
+
+	url := fmt.Sprintf("%s/people/%s/credits", p.httpClient.GetCatalogueBaseURL(), personID)
+	var credits []*Credit
+
+	err = p.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/people/{identifier}/credits"), url, nil, &credits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get person credits: %w", err)
+	}
+
+	var missing []string
+	for _, credit := range credits {
+		if credit.Work == nil && credit.WorkID != "" {
+			missing = append(missing, credit.WorkID)
+		}
+	}
+	if len(missing) == 0 {
+		return credits, nil
+	}
+
+	works := &WorkSvc{httpClient: p.httpClient}
+	fetched, err := getBatched(ctx, missing, works.GetByIdentifiers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get works for person credits: %w", err)
+	}
+
+	byID := make(map[string]*Work, len(fetched))
+	for _, work := range fetched {
+		// The batch endpoint returns null for identifiers it does not know
+		if work != nil {
+			byID[work.ID] = work
+		}
+	}
+	for _, credit := range credits {
+		if credit.Work == nil {
+			credit.Work = byID[credit.WorkID]
+		}
+	}
+
+	return credits, nil
+}
@@ -0,0 +1,92 @@
+package catalogue
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPeopleSvc_Search(t *testing.T) {
+	var query url.Values
+	svc := NewPeopleService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/people/search" {
+			t.Errorf("path = %q, want /people/search", r.URL.Path)
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"items":[{"id":"p1","name":"Funke Akindele"}],"total":1}`))
+	}))
+
+	if _, err := svc.Search(context.Background(), &PersonListOptions{Nationality: "NG"}); err == nil {
+		t.Error("Search() without a query succeeded")
+	}
+
+	deceased := false
+	page, err := svc.Search(context.Background(), &PersonListOptions{Query: "Jenifa", Nationality: "NG", Gender: "female", Deceased: &deceased})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := url.Values{"q": {"Jenifa"}, "nationality": {"NG"}, "gender": {"female"}, "deceased": {"false"}}
+	if query.Encode() != want.Encode() {
+		t.Errorf("query = %q, want %q", query.Encode(), want.Encode())
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "Funke Akindele" {
+		t.Errorf("page = %+v", page)
+	}
+}
+
+func TestPeopleSvc_Credits(t *testing.T) {
+	var batches atomic.Int32
+	var batchQuery string
+	svc := NewPeopleService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/people/p1/credits":
+			_, _ = w.Write([]byte(`[
+				{"id":"c1","workId":"w1","personId":"p1","creditType":"cast","character":"Jenifa","billingOrder":1},
+				{"id":"c2","workId":"w2","personId":"p1","creditType":"crew","department":"Directing","job":"Director"},
+				{"id":"c3","workId":"w1","personId":"p1","creditType":"crew","department":"Production","job":"Producer"},
+				{"id":"c4","workId":"w3","personId":"p1","creditType":"cast","work":{"id":"w3","title":"Embedded"}},
+				{"id":"c5","workId":"gone","personId":"p1","creditType":"cast"}
+			]`))
+		case "/works/batch":
+			batches.Add(1)
+			batchQuery = r.URL.Query().Get("identifiers")
+			// "gone" no longer exists and is returned as null
+			_, _ = w.Write([]byte(`[null,{"id":"w1","title":"Battle on Buka Street"},{"id":"w2","title":"A Tribe Called Judah"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	credits, err := svc.Credits(context.Background(), "p1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := batches.Load(); got != 1 {
+		t.Errorf("made %d batch requests, want 1", got)
+	}
+	if batchQuery != "gone,w1,w2" {
+		t.Errorf("batch identifiers = %q, want each missing work once", batchQuery)
+	}
+
+	wantTitles := []string{"Battle on Buka Street", "A Tribe Called Judah", "Battle on Buka Street", "Embedded", ""}
+	for i, credit := range credits {
+		title := ""
+		if credit.Work != nil {
+			title = credit.Work.Title
+		}
+		if title != wantTitles[i] {
+			t.Errorf("credit %s work = %q, want %q", credit.ID, title, wantTitles[i])
+		}
+	}
+
+	if c := credits[0]; c.CreditType != CreditTypeCast || *c.Character != "Jenifa" || *c.BillingOrder != 1 {
+		t.Errorf("cast credit = %+v", c)
+	}
+	if c := credits[1]; c.CreditType != CreditTypeCrew || *c.Department != "Directing" || *c.Job != "Director" {
+		t.Errorf("crew credit = %+v", c)
+	}
+}
@@ -0,0 +1,50 @@
+package catalogue
+
+// Work types returned in Work.WorkType and accepted by WorkListOptions.WorkType
+const (
+	WorkTypeMovie   = "movie"
+	WorkTypeSeries  = "series"
+	WorkTypeSeason  = "season"
+	WorkTypeEpisode = "episode"
+)
+
+// WorkSort is the order of a work listing
+type WorkSort string
+
+const (
+	WorkSortRelevance       WorkSort = "relevance" // best match first; the default for searches
+	WorkSortTitle           WorkSort = "title"
+	WorkSortReleaseDate     WorkSort = "releaseDate"
+	WorkSortReleaseDateDesc WorkSort = "-releaseDate"
+	WorkSortRatingDesc      WorkSort = "-userRating"
+	WorkSortUpdatedDesc     WorkSort = "-updatedAt"
+)
+
+// WorkListOptions filters and pages work listings. Zero values are not sent.
+type WorkListOptions struct {
+	Query           string   `url:"q"`               // free text search; required by Search
+	WorkType        string   `url:"workType"`        // e.g. WorkTypeMovie
+	Genres          []string `url:"genre"`           // genre slugs; works matching any are returned
+	ReleaseYearFrom int      `url:"releaseYearFrom"` // inclusive
+	ReleaseYearTo   int      `url:"releaseYearTo"`   // inclusive
+	Language        string   `url:"language"`
+	ContentRating   string   `url:"contentRating"`
+	Streamable      *bool    `url:"isStreamable"`
+	InTheatre       *bool    `url:"isInTheatre"`
+	Featured        *bool    `url:"featured"`
+	Sort            WorkSort `url:"sort"`
+	Limit           int      `url:"limit"`  // page size; the server applies its default when zero
+	Cursor          string   `url:"cursor"` // Page.NextCursor of the previous page
+}
+
+// withCursor returns a copy of opts positioned at cursor, or at opts.Cursor if cursor is empty
+func (opts *WorkListOptions) withCursor(cursor string) *WorkListOptions {
+	var o WorkListOptions
+	if opts != nil {
+		o = *opts
+	}
+	if cursor != "" {
+		o.Cursor = cursor
+	}
+	return &o
+}
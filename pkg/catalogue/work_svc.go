@@ -1,8 +1,11 @@
 package catalogue
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 
 	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
@@ -16,7 +19,10 @@ func NewWorkService(httpClient httpclient.Client) WorkService {
 }
 
 // GetByIdentifier retrieves a work by its identifier
-func (w *WorkSvc) GetByIdentifier(ctx context.Context, identifier string) (*Work, error) {
+func (w *WorkSvc) GetByIdentifier(ctx context.Context, identifier string) (_ *Work, err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.GetByIdentifier")
+	defer func() { httpclient.EndSpan(span, err) }()
+
 	if identifier == "" {
 		return nil, fmt.Errorf("identifier cannot be empty")
 	}
@@ -24,7 +30,7 @@ func (w *WorkSvc) GetByIdentifier(ctx context.Context, identifier string) (*Work
 	url := fmt.Sprintf("%s/works/%s", w.httpClient.GetCatalogueBaseURL(), identifier)
 	var work Work
 
-	err := w.httpClient.Get(ctx, url, nil, &work)
+	err = w.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/works/{identifier}"), url, nil, &work)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get work: %w", err)
 	}
@@ -33,7 +39,10 @@ func (w *WorkSvc) GetByIdentifier(ctx context.Context, identifier string) (*Work
 }
 
 // GetByIdentifiers retrieves multiple works by their identifiers
-func (w *WorkSvc) GetByIdentifiers(ctx context.Context, identifiers []string) ([]*Work, error) {
+func (w *WorkSvc) GetByIdentifiers(ctx context.Context, identifiers []string) (_ []*Work, err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.GetByIdentifiers")
+	defer func() { httpclient.EndSpan(span, err) }()
+
 	if len(identifiers) == 0 {
 		return nil, fmt.Errorf("identifiers cannot be empty")
 	}
@@ -45,10 +54,215 @@ func (w *WorkSvc) GetByIdentifiers(ctx context.Context, identifiers []string) ([
 
 	var works []*Work
 
-	err := w.httpClient.Get(ctx, url, params, &works)
+	err = w.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/works/batch"), url, params, &works)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get works: %w", err)
 	}
 
 	return works, nil
 }
+
+// List retrieves a page of works matching the filters in opts
+func (w *WorkSvc) List(ctx context.Context, opts *WorkListOptions) (_ *Page[Work], err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.List")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	url := withQuery(fmt.Sprintf("%s/works", w.httpClient.GetCatalogueBaseURL()), opts)
+	var page Page[Work]
+
+	err = w.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/works"), url, nil, &page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list works: %w", err)
+	}
+
+	return &page, nil
+}
+
+// Search retrieves a page of works matching opts.Query and the other filters in opts
+func (w *WorkSvc) Search(ctx context.Context, opts *WorkListOptions) (_ *Page[Work], err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.Search")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if opts == nil || strings.TrimSpace(opts.Query) == "" {
+		return nil, fmt.Errorf("query cannot be empty")
+	}
+
+	url := withQuery(fmt.Sprintf("%s/works/search", w.httpClient.GetCatalogueBaseURL()), opts)
+	var page Page[Work]
+
+	err = w.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/works/search"), url, nil, &page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search works: %w", err)
+	}
+
+	return &page, nil
+}
+
+// ListAll iterates over every work matching opts, fetching pages as the loop advances
+func (w *WorkSvc) ListAll(ctx context.Context, opts *WorkListOptions, iterOpts ...IterOption) iter.Seq2[*Work, error] {
+	return Iterate(ctx, func(ctx context.Context, cursor string) (*Page[Work], error) {
+		return w.List(ctx, opts.withCursor(cursor))
+	}, iterOpts...)
+}
+
+// SearchAll iterates over every work matching opts.Query, fetching pages as the loop advances
+func (w *WorkSvc) SearchAll(ctx context.Context, opts *WorkListOptions, iterOpts ...IterOption) iter.Seq2[*Work, error] {
+	return Iterate(ctx, func(ctx context.Context, cursor string) (*Page[Work], error) {
+		return w.Search(ctx, opts.withCursor(cursor))
+	}, iterOpts...)
+}
+
+// Credits retrieves the cast and crew of a work. With WithPeople, every credit's Person
+// is filled in using one batched lookup instead of a request per person.
+func (w *WorkSvc) Credits(ctx context.Context, workID string, opts ...CreditsOption) (_ *WorkCredits, err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.Credits")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if workID == "" {
+		return nil, fmt.Errorf("workID cannot be empty")
+	}
+
+	var cfg creditsConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	url := fmt.Sprintf("%s/works/%s/credits", w.httpClient.GetCatalogueBaseURL(), workID)
+	var credits []*Credit
+
+	err = w.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/works/{identifier}/credits"), url, nil, &credits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work credits: %w", err)
+	}
+
+	workCredits := newWorkCredits(workID, credits)
+
+	if cfg.hydratePeople {
+		if err = workCredits.ResolvePeople(ctx, &PeopleSvc{httpClient: w.httpClient}); err != nil {
+			return nil, err
+		}
+	}
+
+	return workCredits, nil
+}
+
+// Children retrieves the works directly below a work, e.g. the seasons of a series
+func (w *WorkSvc) Children(ctx context.Context, identifier string) (_ []*Work, err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.Children")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if identifier == "" {
+		return nil, fmt.Errorf("identifier cannot be empty")
+	}
+
+	url := fmt.Sprintf("%s/works/%s/children", w.httpClient.GetCatalogueBaseURL(), identifier)
+	var works []*Work
+
+	err = w.httpClient.Get(httpclient.WithCatalogueEndpoint(ctx, "/works/{identifier}/children"), url, nil, &works)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work children: %w", err)
+	}
+
+	return works, nil
+}
+
+// Seasons retrieves the seasons of a series, ordered by season number
+func (w *WorkSvc) Seasons(ctx context.Context, seriesID string) (_ []*Work, err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.Seasons")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	children, err := w.Children(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons := slices.DeleteFunc(children, func(child *Work) bool { return !isSeason(child) })
+	slices.SortStableFunc(seasons, func(a, b *Work) int {
+		return cmp.Compare(intValue(a.SeasonNumber), intValue(b.SeasonNumber))
+	})
+
+	return seasons, nil
+}
+
+// Episodes retrieves the episodes of one season of a series, ordered by episode number.
+// Episodes attached directly to the series are included when their SeasonNumber matches.
+func (w *WorkSvc) Episodes(ctx context.Context, seriesID string, season int) (_ []*Work, err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.Episodes")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	children, err := w.Children(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	var episodes []*Work
+	found := false
+	for _, child := range children {
+		switch {
+		case isSeason(child) && intValue(child.SeasonNumber) == season:
+			found = true
+			seasonChildren, err := w.Children(ctx, child.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, episode := range seasonChildren {
+				if isEpisode(episode) {
+					episodes = append(episodes, episode)
+				}
+			}
+		case isEpisode(child) && intValue(child.SeasonNumber) == season:
+			found = true
+			episodes = append(episodes, child)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("series %s has no season %d", seriesID, season)
+	}
+
+	sortEpisodes(episodes)
+	return episodes, nil
+}
+
+// Hierarchy retrieves a series with all its seasons and episodes as a tree, ordered by season
+// and episode number, with gaps in the numbering and the total runtime worked out.
+// It makes one request for the series, one for its children and one per season.
+func (w *WorkSvc) Hierarchy(ctx context.Context, seriesID string) (_ *SeriesTree, err error) {
+	ctx, span := w.httpClient.StartSpan(ctx, "WorkService.Hierarchy")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	series, err := w.GetByIdentifier(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := w.Children(ctx, series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var seasons []*Work
+	episodes := make(map[int][]*Work)
+	for _, child := range children {
+		switch {
+		case isSeason(child):
+			seasons = append(seasons, child)
+
+			seasonChildren, err := w.Children(ctx, child.ID)
+			if err != nil {
+				return nil, err
+			}
+			number := intValue(child.SeasonNumber)
+			for _, episode := range seasonChildren {
+				if isEpisode(episode) {
+					episodes[number] = append(episodes[number], episode)
+				}
+			}
+		case isEpisode(child):
+			number := intValue(child.SeasonNumber)
+			episodes[number] = append(episodes[number], child)
+		}
+	}
+
+	return buildSeriesTree(series, seasons, episodes), nil
+}
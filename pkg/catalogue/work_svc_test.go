@@ -0,0 +1,143 @@
+package catalogue
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkSvc_List(t *testing.T) {
+	var query url.Values
+	svc := NewWorkService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/works" {
+			t.Errorf("path = %q, want /works", r.URL.Path)
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"items":[{"id":"w1","title":"King of Boys"}],"total":41,"nextCursor":"c2"}`))
+	}))
+
+	streamable := false
+	page, err := svc.List(context.Background(), &WorkListOptions{
+		WorkType:        WorkTypeMovie,
+		Genres:          []string{"drama", "crime"},
+		ReleaseYearFrom: 2015,
+		Streamable:      &streamable,
+		Sort:            WorkSortReleaseDateDesc,
+		Cursor:          "c1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := url.Values{
+		"workType":        {"movie"},
+		"genre":           {"drama", "crime"},
+		"releaseYearFrom": {"2015"},
+		"isStreamable":    {"false"},
+		"sort":            {"-releaseDate"},
+		"cursor":          {"c1"},
+	}
+	if query.Encode() != want.Encode() {
+		t.Errorf("query = %q, want %q", query.Encode(), want.Encode())
+	}
+
+	if len(page.Items) != 1 || page.Items[0].Title != "King of Boys" || page.Total != 41 || !page.HasMore() {
+		t.Errorf("page = %+v", page)
+	}
+}
+
+func TestWorkSvc_Search(t *testing.T) {
+	var path, q string
+	svc := NewWorkService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path, q = r.URL.Path, r.URL.Query().Get("q")
+		_, _ = w.Write([]byte(`{"items":[],"total":0}`))
+	}))
+
+	if _, err := svc.Search(context.Background(), &WorkListOptions{}); err == nil {
+		t.Error("Search() without a query succeeded")
+	}
+
+	page, err := svc.Search(context.Background(), &WorkListOptions{Query: "anikulapo & co"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != "/works/search" || q != "anikulapo & co" {
+		t.Errorf("request = %q q=%q", path, q)
+	}
+	if page.HasMore() || page.Total != 0 {
+		t.Errorf("page = %+v, want an empty last page", page)
+	}
+}
+
+func TestWorkSvc_Credits(t *testing.T) {
+	creditsJSON := `[
+		{"id":"c1","workId":"w1","personId":"p3","creditType":"crew","department":"Writing","job":"Screenplay"},
+		{"id":"c2","workId":"w1","personId":"p2","creditType":"cast","character":"Eniola","billingOrder":2},
+		{"id":"c3","workId":"w1","personId":"p4","creditType":"cast","character":"Extra"},
+		{"id":"c4","workId":"w1","personId":"p1","creditType":"cast","character":"Makanaki","billingOrder":1,"person":{"id":"p1","name":"Embedded"}},
+		{"id":"c5","workId":"w1","personId":"p2","creditType":"crew","department":"Directing","job":"Director"}
+	]`
+
+	tests := []struct {
+		name        string
+		opts        []CreditsOption
+		wantBatches int32
+		wantPeople  []string // names of the cast then crew; "" for unresolved
+	}{
+		{name: "without people", wantPeople: []string{"Embedded", "", "", "", ""}},
+		{name: "with people", opts: []CreditsOption{WithPeople()}, wantBatches: 1, wantPeople: []string{"Embedded", "Femi Adebayo", "", "Femi Adebayo", "Kunle Afolayan"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches atomic.Int32
+			var batchQuery string
+			svc := NewWorkService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/works/w1/credits":
+					_, _ = w.Write([]byte(creditsJSON))
+				case "/people/batch":
+					batches.Add(1)
+					batchQuery = r.URL.Query().Get("identifiers")
+					// p4 no longer exists and is returned as null
+					_, _ = w.Write([]byte(`[{"id":"p2","name":"Femi Adebayo"},{"id":"p3","name":"Kunle Afolayan"},null]`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			credits, err := svc.Credits(context.Background(), "w1", tt.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var order []string
+			var people []string
+			for _, credit := range credits.All() {
+				order = append(order, credit.ID)
+				name := ""
+				if credit.Person != nil {
+					name = credit.Person.Name
+				}
+				people = append(people, name)
+			}
+
+			// Cast by billing order with unbilled last, then crew by department
+			if got, want := strings.Join(order, ","), "c4,c2,c3,c5,c1"; got != want {
+				t.Errorf("credit order = %s, want %s", got, want)
+			}
+			if got, want := strings.Join(people, ","), strings.Join(tt.wantPeople, ","); got != want {
+				t.Errorf("people = %s, want %s", got, want)
+			}
+			if got := batches.Load(); got != tt.wantBatches {
+				t.Errorf("made %d batch requests, want %d", got, tt.wantBatches)
+			}
+			if tt.wantBatches > 0 && batchQuery != "p2,p3,p4" {
+				t.Errorf("batch identifiers = %q, want the people not embedded", batchQuery)
+			}
+		})
+	}
+}
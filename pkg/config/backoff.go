@@ -0,0 +1,29 @@
+package config
+
+import (
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+)
+
+// LinearBackoff waits base * attempt between retries, capped at max (no cap if max is zero)
+func LinearBackoff(base, max time.Duration) BackoffStrategy {
+	return httpclient.NewLinearBackoff(base, max)
+}
+
+// ExponentialBackoff waits base * 2^(attempt-1) between retries, capped at max (no cap if max is zero)
+func ExponentialBackoff(base, max time.Duration) BackoffStrategy {
+	return httpclient.NewExponentialBackoff(base, max)
+}
+
+// FullJitterBackoff waits a random duration between zero and the exponential delay,
+// capped at max (no cap if max is zero)
+func FullJitterBackoff(base, max time.Duration) BackoffStrategy {
+	return httpclient.NewFullJitterBackoff(base, max)
+}
+
+// DecorrelatedJitterBackoff waits a random duration between base and three times the
+// previous delay, capped at max (no cap if max is zero)
+func DecorrelatedJitterBackoff(base, max time.Duration) BackoffStrategy {
+	return httpclient.NewDecorrelatedJitterBackoff(base, max)
+}
@@ -2,7 +2,12 @@ package config
 
 import (
 	"fmt"
+	"log/slog"
+	"net/http"
 	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
 )
 
 func WithApiKey(apiKey string) Option {
@@ -11,6 +16,22 @@ func WithApiKey(apiKey string) Option {
 	}
 }
 
+// WithTokenSource sets a source of access tokens used instead of logging in with an API key,
+// e.g. auth.StaticTokenSource for a pre-issued bearer token
+func WithTokenSource(source auth.TokenSource) Option {
+	return func(c *Config) {
+		c.TokenSource = source
+	}
+}
+
+// WithTokenStore sets the store the client loads its token from on startup and saves it to
+// after login and refresh, e.g. auth.NewFileTokenStore
+func WithTokenStore(store auth.TokenStore) Option {
+	return func(c *Config) {
+		c.TokenStore = store
+	}
+}
+
 func WithTimeout(timeout time.Duration) Option {
 	return func(c *Config) {
 		c.Timeout = timeout
@@ -29,12 +50,97 @@ func WithMaxRetries(maxRetries int) Option {
 	}
 }
 
+// WithMaxRetryAfter sets the longest Retry-After the client waits for. When a server asks for
+// a longer wait the request fails instead of blocking the caller.
+func WithMaxRetryAfter(max time.Duration) Option {
+	return func(c *Config) {
+		c.MaxRetryAfter = max
+	}
+}
+
 func WithUserAgent(userAgent string) Option {
 	return func(c *Config) {
 		c.UserAgent = userAgent
 	}
 }
 
+func WithBackoff(backoff BackoffStrategy) Option {
+	return func(c *Config) {
+		c.Backoff = backoff
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Config) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithMiddleware appends middleware to the chain; the first middleware added is the outermost
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(c *Config) {
+		c.Middleware = append(c.Middleware, middleware...)
+	}
+}
+
+// WithHTTPClient sets the base HTTP client used to send requests. The SDK copies it and still
+// applies authentication, retries and the user agent on top. Timeout is used when the client has none.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Config) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithTransport sets the transport used to send requests, e.g. for custom TLS roots,
+// mTLS certificates, proxies or instrumentation
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Config) {
+		c.Transport = transport
+	}
+}
+
+// WithLogger sets the logger used for request, retry and auth events.
+// API keys and tokens are always redacted from the output.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
+
+// WithTracer sets the tracer used to create spans for service calls, HTTP attempts and token acquisition
+func WithTracer(tracer Tracer) Option {
+	return func(c *Config) {
+		c.Tracer = tracer
+	}
+}
+
+// WithMetrics sets the receiver for client metrics
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Config) {
+		c.Metrics = metrics
+	}
+}
+
+func WithTokenRefreshWindow(window time.Duration) Option {
+	return func(c *Config) {
+		c.TokenRefreshWindow = window
+	}
+}
+
+func WithClockSkew(skew time.Duration) Option {
+	return func(c *Config) {
+		c.ClockSkew = skew
+	}
+}
+
+// WithBackgroundTokenRefresh renews the access token in a background goroutine before it
+// expires. Call Close on the client to stop it.
+func WithBackgroundTokenRefresh(enabled bool) Option {
+	return func(c *Config) {
+		c.BackgroundTokenRefresh = enabled
+	}
+}
+
 func WithIAMBaseURL(url string) Option {
 	return func(c *Config) {
 		c.IAMBaseURL = url
@@ -47,6 +153,16 @@ func WithCatalogueBaseURL(url string) Option {
 	}
 }
 
+// WithCatalogueFallbackURLs sets catalogue endpoints, e.g. in other regions, that requests fail over
+// to in order when the current endpoint fails with a connection error or 5xx response. A failed
+// endpoint is avoided for a while, so later requests go straight to the one that last worked.
+// Failing over uses the retry budget: it only happens for requests the RetryPolicy would retry.
+func WithCatalogueFallbackURLs(urls ...string) Option {
+	return func(c *Config) {
+		c.CatalogueFallbackURLs = urls
+	}
+}
+
 // DefaultConfig returns a Config struct populated with default values.
 func DefaultConfig(iamBaseUrl, catalogueBaseUrl string) *Config {
 	return &Config{
@@ -55,13 +171,18 @@ func DefaultConfig(iamBaseUrl, catalogueBaseUrl string) *Config {
 		Timeout:          15 * time.Second,
 		MaxRetries:       3,
 		RetryDelay:       2 * time.Second,
+		MaxRetryAfter:    httpclient.DefaultMaxRetryAfter,
 		UserAgent:        fmt.Sprintf("nollywood-go-sdk/%s", SDK_VERSION),
+
+		TokenRefreshWindow: 30 * time.Second,
+		ClockSkew:          10 * time.Second,
 	}
 }
 
-// NewConfig creates a new Config with the given options
+// NewConfig creates a new Config with the DefaultConfig values overridden by the given options.
+// The base URLs have no default and must be set by an option such as WithEnvironment.
 func NewConfig(options ...Option) *Config {
-	config := &Config{}
+	config := DefaultConfig("", "")
 
 	for _, option := range options {
 		option(config)
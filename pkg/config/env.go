@@ -0,0 +1,293 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting is a configuration value that can be read from the environment or a file
+type setting struct {
+	env   string // environment variable and dotenv key
+	json  string // key in JSON files
+	apply func(c *Config, value string) error
+}
+
+// settings lists every value FromEnv and FromFile understand.
+// Durations use Go syntax such as "15s" or "2m"; booleans use strconv.ParseBool syntax.
+var settings = []setting{
+	// The environment comes first so that the individual URL settings override it
+	{"NOLLYWOOD_ENVIRONMENT", "environment", environmentSetting},
+	{"NOLLYWOOD_API_KEY", "apiKey", stringSetting(func(c *Config) *string { return &c.ApiKey })},
+	{"NOLLYWOOD_IAM_BASE_URL", "iamBaseUrl", stringSetting(func(c *Config) *string { return &c.IAMBaseURL })},
+	{"NOLLYWOOD_CATALOGUE_BASE_URL", "catalogueBaseUrl", stringSetting(func(c *Config) *string { return &c.CatalogueBaseURL })},
+	{"NOLLYWOOD_CATALOGUE_FALLBACK_URLS", "catalogueFallbackUrls", listSetting(func(c *Config) *[]string { return &c.CatalogueFallbackURLs })},
+	{"NOLLYWOOD_USER_AGENT", "userAgent", stringSetting(func(c *Config) *string { return &c.UserAgent })},
+	{"NOLLYWOOD_TIMEOUT", "timeout", durationSetting(func(c *Config) *time.Duration { return &c.Timeout })},
+	{"NOLLYWOOD_RETRY_DELAY", "retryDelay", durationSetting(func(c *Config) *time.Duration { return &c.RetryDelay })},
+	{"NOLLYWOOD_MAX_RETRIES", "maxRetries", intSetting(func(c *Config) *int { return &c.MaxRetries })},
+	{"NOLLYWOOD_MAX_RETRY_AFTER", "maxRetryAfter", durationSetting(func(c *Config) *time.Duration { return &c.MaxRetryAfter })},
+	{"NOLLYWOOD_TOKEN_REFRESH_WINDOW", "tokenRefreshWindow", durationSetting(func(c *Config) *time.Duration { return &c.TokenRefreshWindow })},
+	{"NOLLYWOOD_CLOCK_SKEW", "clockSkew", durationSetting(func(c *Config) *time.Duration { return &c.ClockSkew })},
+	{"NOLLYWOOD_BACKGROUND_TOKEN_REFRESH", "backgroundTokenRefresh", boolSetting(func(c *Config) *bool { return &c.BackgroundTokenRefresh })},
+}
+
+func environmentSetting(c *Config, value string) error {
+	env, ok := LookupEnvironment(value)
+	if !ok {
+		names := environmentNames()
+		if len(names) == 0 {
+			return fmt.Errorf("unknown environment, none registered with RegisterEnvironment")
+		}
+		return fmt.Errorf("unknown environment, want one of %s", strings.Join(names, ", "))
+	}
+	WithEnvironment(env)(c)
+	return nil
+}
+
+func stringSetting(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, value string) error {
+		*field(c) = value
+		return nil
+	}
+}
+
+// listSetting reads a comma-separated list; JSON files may also use an array of strings
+func listSetting(field func(*Config) *[]string) func(*Config, string) error {
+	return func(c *Config, value string) error {
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		*field(c) = items
+		return nil
+	}
+}
+
+func durationSetting(field func(*Config) *time.Duration) func(*Config, string) error {
+	return func(c *Config, value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+func intSetting(field func(*Config) *int) func(*Config, string) error {
+	return func(c *Config, value string) error {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func boolSetting(field func(*Config) *bool) func(*Config, string) error {
+	return func(c *Config, value string) error {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = b
+		return nil
+	}
+}
+
+// FromEnv returns an Option setting the values found in NOLLYWOOD_* environment variables,
+// such as NOLLYWOOD_API_KEY, NOLLYWOOD_CATALOGUE_BASE_URL and NOLLYWOOD_TIMEOUT.
+// Unset and empty variables leave the corresponding values untouched.
+//
+// Options are applied in order, so later options take precedence. The usual layering is
+//
+//	config.NewConfig(fileOption, envOption, config.WithTimeout(...))
+//
+// where values set in code override the environment, which overrides the file.
+func FromEnv() (Option, error) {
+	values := make(map[string]string)
+	for _, s := range settings {
+		if value := os.Getenv(s.env); value != "" {
+			values[s.env] = value
+		}
+	}
+
+	return newSettingsOption(values, "environment variable")
+}
+
+// FromFile returns an Option setting the values found in the file at path, which is read
+// once when FromFile is called. Files ending in .json hold an object keyed by the JSON names
+// (e.g. "apiKey", "catalogueBaseUrl", "timeout"); other files use dotenv syntax with the same
+// keys as FromEnv. Unknown keys are reported as errors, except dotenv keys without the
+// NOLLYWOOD_ prefix, which may belong to other tools. See FromEnv for how options compose.
+func FromFile(path string) (Option, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var values map[string]string
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		values, err = parseJSONSettings(data)
+	} else {
+		values, err = parseDotenv(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	option, err := newSettingsOption(values, "setting")
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return option, nil
+}
+
+// newSettingsOption validates values, keyed by environment variable name, and returns an
+// Option applying them. All invalid values are reported together.
+func newSettingsOption(values map[string]string, kind string) (Option, error) {
+	var errs []error
+	var apply []func(*Config)
+
+	for _, s := range settings {
+		// Empty values count as unset, as they do in the environment
+		value := values[s.env]
+		if value == "" {
+			continue
+		}
+
+		// Parse once up front so that problems surface here rather than when the option is applied
+		var parsed Config
+		if err := s.apply(&parsed, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: invalid value %q: %w", kind, s.env, value, err))
+			continue
+		}
+
+		apply = append(apply, func(c *Config) { _ = s.apply(c, value) })
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return func(c *Config) {
+		for _, fn := range apply {
+			fn(c)
+		}
+	}, nil
+}
+
+// parseJSONSettings reads a JSON object keyed by setting JSON names and returns the values
+// keyed by environment variable name. Unknown keys are rejected to catch typos.
+func parseJSONSettings(data []byte) (map[string]string, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	byJSONName := make(map[string]string, len(settings))
+	for _, s := range settings {
+		byJSONName[s.json] = s.env
+	}
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make(map[string]string, len(raw))
+	var errs []error
+	for _, key := range keys {
+		env, ok := byJSONName[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown key %q", key))
+			continue
+		}
+
+		switch v := raw[key].(type) {
+		case string:
+			values[env] = v
+		case float64:
+			values[env] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			values[env] = strconv.FormatBool(v)
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				str, ok := item.(string)
+				if !ok {
+					errs = append(errs, fmt.Errorf("key %q: unsupported list item type %T", key, item))
+					break
+				}
+				items = append(items, str)
+			}
+			values[env] = strings.Join(items, ",")
+		case nil:
+			// null leaves the value untouched
+		default:
+			errs = append(errs, fmt.Errorf("key %q: unsupported value type %T", key, v))
+		}
+	}
+
+	return values, errors.Join(errs...)
+}
+
+// parseDotenv reads KEY=VALUE lines. Blank lines, # comments and an "export " prefix are
+// allowed; values may be wrapped in single or double quotes. Other tools' variables are
+// ignored, but unknown NOLLYWOOD_* keys are rejected to catch typos, as in JSON files.
+func parseDotenv(data []byte) (map[string]string, error) {
+	known := make(map[string]bool, len(settings))
+	for _, s := range settings {
+		known[s.env] = true
+	}
+
+	values := make(map[string]string)
+	var errs []error
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", lineNo)
+		}
+
+		value = strings.TrimSpace(value)
+		if n := len(value); n >= 2 && (value[0] == '"' || value[0] == '\'') && value[n-1] == value[0] {
+			value = value[1 : n-1]
+		} else if i := strings.Index(value, " #"); i >= 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+
+		if strings.HasPrefix(key, "NOLLYWOOD_") && !known[key] {
+			errs = append(errs, fmt.Errorf("line %d: unknown key %q", lineNo, key))
+			continue
+		}
+
+		values[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, errors.Join(errs...)
+}
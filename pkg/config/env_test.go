@@ -0,0 +1,181 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFromFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+		check   func(t *testing.T, c *Config)
+	}{
+		{
+			name: "dotenv",
+			file: ".env",
+			content: `# Nollywood settings
+export NOLLYWOOD_API_KEY="sk_test"
+NOLLYWOOD_TIMEOUT=20s # per request
+NOLLYWOOD_MAX_RETRIES = 5
+NOLLYWOOD_USER_AGENT='my-app/1.0 #1'
+NOLLYWOOD_CLOCK_SKEW=
+OTHER_TOOL_SETTING=ignored
+`,
+			check: func(t *testing.T, c *Config) {
+				if c.ApiKey != "sk_test" || c.Timeout != 20*time.Second || c.MaxRetries != 5 || c.UserAgent != "my-app/1.0 #1" {
+					t.Errorf("config = %+v", c)
+				}
+				if want := DefaultConfig("", "").ClockSkew; c.ClockSkew != want {
+					t.Errorf("ClockSkew = %v, want the default %v kept for an empty value", c.ClockSkew, want)
+				}
+			},
+		},
+		{
+			name:    "json",
+			file:    "nollywood.json",
+			content: `{"apiKey": "sk_json", "catalogueBaseUrl": "https://catalogue.test", "catalogueFallbackUrls": ["https://us.catalogue.test"], "retryDelay": "500ms", "maxRetries": 2, "backgroundTokenRefresh": true}`,
+			check: func(t *testing.T, c *Config) {
+				if c.ApiKey != "sk_json" || c.CatalogueBaseURL != "https://catalogue.test" || c.RetryDelay != 500*time.Millisecond ||
+					c.MaxRetries != 2 || !c.BackgroundTokenRefresh || !slices.Equal(c.CatalogueFallbackURLs, []string{"https://us.catalogue.test"}) {
+					t.Errorf("config = %+v", c)
+				}
+			},
+		},
+		{
+			name:    "json unknown key",
+			file:    "nollywood.json",
+			content: `{"apiKye": "typo"}`,
+			wantErr: `unknown key "apiKye"`,
+		},
+		{
+			name:    "dotenv unknown key",
+			file:    ".env",
+			content: "OTHER_TOOL_SETTING=ignored\nNOLLYWOOD_TIMOUT=20s\nNOLLYWOOD_API_KEY=sk_test\nNOLLYWOOD_RETRYS=2\n",
+			wantErr: "line 2: unknown key \"NOLLYWOOD_TIMOUT\"\nline 4: unknown key \"NOLLYWOOD_RETRYS\"",
+		},
+		{
+			name:    "dotenv malformed line",
+			file:    ".env",
+			content: "NOLLYWOOD_API_KEY=x\nnot a setting\n",
+			wantErr: "line 2",
+		},
+		{
+			name:    "all invalid values are reported",
+			file:    ".env",
+			content: "NOLLYWOOD_TIMEOUT=15\nNOLLYWOOD_MAX_RETRIES=many\n",
+			wantErr: "NOLLYWOOD_TIMEOUT: invalid value \"15\": time: missing unit in duration \"15\"\nsetting NOLLYWOOD_MAX_RETRIES",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			option, err := FromFile(writeFile(t, tt.file, tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tt.check(t, NewConfig(option))
+		})
+	}
+}
+
+func TestFromEnv_Precedence(t *testing.T) {
+	t.Setenv("NOLLYWOOD_API_KEY", "sk_env")
+	t.Setenv("NOLLYWOOD_TIMEOUT", "30s")
+	t.Setenv("NOLLYWOOD_CATALOGUE_BASE_URL", "")
+
+	fileOption, err := FromFile(writeFile(t, ".env", "NOLLYWOOD_API_KEY=sk_file\nNOLLYWOOD_CATALOGUE_BASE_URL=https://file.test\nNOLLYWOOD_TIMEOUT=5s\n"))
+	if err != nil {
+		t.Fatalf("FromFile() error: %v", err)
+	}
+	envOption, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error: %v", err)
+	}
+
+	c := NewConfig(fileOption, envOption, WithTimeout(time.Minute))
+
+	if c.ApiKey != "sk_env" {
+		t.Errorf("ApiKey = %q, want the environment to override the file", c.ApiKey)
+	}
+	if c.CatalogueBaseURL != "https://file.test" {
+		t.Errorf("CatalogueBaseURL = %q, want the file value kept when the variable is empty", c.CatalogueBaseURL)
+	}
+	if c.Timeout != time.Minute {
+		t.Errorf("Timeout = %v, want the explicit option to override the environment", c.Timeout)
+	}
+}
+
+func TestFromEnv_InvalidValue(t *testing.T) {
+	t.Setenv("NOLLYWOOD_BACKGROUND_TOKEN_REFRESH", "sometimes")
+
+	if _, err := FromEnv(); err == nil || !strings.Contains(err.Error(), "environment variable NOLLYWOOD_BACKGROUND_TOKEN_REFRESH") {
+		t.Fatalf("error = %v, want invalid NOLLYWOOD_BACKGROUND_TOKEN_REFRESH", err)
+	}
+}
+
+func TestFromEnv_Environment(t *testing.T) {
+	staging := Environment{
+		Name:              "env-test-staging",
+		IAMBaseURL:        "https://iam.staging.test",
+		CatalogueBaseURLs: []string{"https://eu.catalogue.staging.test", "https://us.catalogue.staging.test"},
+	}
+	RegisterEnvironment(staging)
+
+	t.Setenv("NOLLYWOOD_ENVIRONMENT", "ENV-TEST-STAGING")
+	t.Setenv("NOLLYWOOD_CATALOGUE_BASE_URL", "https://catalogue.override.test")
+
+	envOption, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error: %v", err)
+	}
+
+	c := NewConfig(envOption)
+	if c.IAMBaseURL != staging.IAMBaseURL {
+		t.Errorf("IAMBaseURL = %q, want the registered environment's %q", c.IAMBaseURL, staging.IAMBaseURL)
+	}
+	if c.CatalogueBaseURL != "https://catalogue.override.test" {
+		t.Errorf("CatalogueBaseURL = %q, want the explicit variable to override the preset", c.CatalogueBaseURL)
+	}
+	if !slices.Equal(c.CatalogueFallbackURLs, staging.CatalogueBaseURLs[1:]) {
+		t.Errorf("CatalogueFallbackURLs = %v, want the environment's %v", c.CatalogueFallbackURLs, staging.CatalogueBaseURLs[1:])
+	}
+
+	t.Setenv("NOLLYWOOD_CATALOGUE_FALLBACK_URLS", "https://us.catalogue.test, https://af.catalogue.test")
+	envOption, err = FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error: %v", err)
+	}
+	want := []string{"https://us.catalogue.test", "https://af.catalogue.test"}
+	if c := NewConfig(envOption); !slices.Equal(c.CatalogueFallbackURLs, want) {
+		t.Errorf("CatalogueFallbackURLs = %v, want %v", c.CatalogueFallbackURLs, want)
+	}
+
+	t.Setenv("NOLLYWOOD_ENVIRONMENT", "qa")
+	if _, err := FromEnv(); err == nil {
+		t.Error("FromEnv() accepted an unknown environment")
+	}
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Environment is a named set of service endpoints. A multi-region deployment lists one
+// catalogue endpoint per region, in the order they should be tried:
+//
+//	config.Environment{
+//		Name:              "production",
+//		IAMBaseURL:        "https://iam.example.com",
+//		CatalogueBaseURLs: []string{"https://eu.catalogue.example.com", "https://us.catalogue.example.com"},
+//	}
+type Environment struct {
+	Name              string
+	IAMBaseURL        string
+	CatalogueBaseURLs []string // primary endpoint first, then failover endpoints in order
+}
+
+// No environments are built in, as the SDK cannot know the endpoints of a given deployment.
+// Describe them with an Environment and register them with RegisterEnvironment.
+var (
+	environmentsMu sync.RWMutex
+	// environments are the environments LookupEnvironment knows about
+	environments []Environment
+)
+
+// RegisterEnvironment makes env available to LookupEnvironment and NOLLYWOOD_ENVIRONMENT,
+// replacing any environment with the same name
+func RegisterEnvironment(env Environment) {
+	environmentsMu.Lock()
+	defer environmentsMu.Unlock()
+
+	env.CatalogueBaseURLs = slices.Clone(env.CatalogueBaseURLs)
+	for i, existing := range environments {
+		if strings.EqualFold(existing.Name, env.Name) {
+			environments[i] = env
+			return
+		}
+	}
+	environments = append(environments, env)
+}
+
+// LookupEnvironment returns the registered environment with the given name, ignoring case
+func LookupEnvironment(name string) (Environment, bool) {
+	environmentsMu.RLock()
+	defer environmentsMu.RUnlock()
+
+	for _, env := range environments {
+		if strings.EqualFold(env.Name, name) {
+			return env, true
+		}
+	}
+	return Environment{}, false
+}
+
+// environmentNames returns the names of the registered environments
+func environmentNames() []string {
+	environmentsMu.RLock()
+	defer environmentsMu.RUnlock()
+
+	names := make([]string, 0, len(environments))
+	for _, env := range environments {
+		names = append(names, env.Name)
+	}
+	return names
+}
+
+// WithEnvironment sets the IAM and catalogue endpoints of env. Later options such as
+// WithCatalogueBaseURL override individual endpoints.
+func WithEnvironment(env Environment) Option {
+	return func(c *Config) {
+		c.IAMBaseURL = env.IAMBaseURL
+		c.CatalogueBaseURL = ""
+		c.CatalogueFallbackURLs = nil
+		if len(env.CatalogueBaseURLs) > 0 {
+			c.CatalogueBaseURL = env.CatalogueBaseURLs[0]
+			c.CatalogueFallbackURLs = append([]string(nil), env.CatalogueBaseURLs[1:]...)
+		}
+	}
+}
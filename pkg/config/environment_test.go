@@ -0,0 +1,52 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithEnvironment(t *testing.T) {
+	regional := Environment{
+		Name:              "regional",
+		IAMBaseURL:        "https://iam.test",
+		CatalogueBaseURLs: []string{"https://eu.catalogue.test", "https://us.catalogue.test", "https://af.catalogue.test"},
+	}
+
+	c := NewConfig(WithEnvironment(regional), WithApiKey("sk_test"))
+
+	if c.IAMBaseURL != regional.IAMBaseURL || c.CatalogueBaseURL != "https://eu.catalogue.test" {
+		t.Errorf("base URLs = %q, %q", c.IAMBaseURL, c.CatalogueBaseURL)
+	}
+	if !slices.Equal(c.CatalogueFallbackURLs, regional.CatalogueBaseURLs[1:]) {
+		t.Errorf("CatalogueFallbackURLs = %v, want %v", c.CatalogueFallbackURLs, regional.CatalogueBaseURLs[1:])
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+
+	// Switching environment drops the previous fallbacks
+	local := Environment{Name: "local", IAMBaseURL: "http://localhost:9000", CatalogueBaseURLs: []string{"http://localhost:9001"}}
+	c = NewConfig(WithEnvironment(regional), WithEnvironment(local))
+	if c.CatalogueBaseURL != local.CatalogueBaseURLs[0] || len(c.CatalogueFallbackURLs) != 0 {
+		t.Errorf("catalogue URLs = %q, %v; want the local environment only", c.CatalogueBaseURL, c.CatalogueFallbackURLs)
+	}
+}
+
+func TestRegisterEnvironment(t *testing.T) {
+	if found, ok := LookupEnvironment("unregistered"); ok {
+		t.Errorf("LookupEnvironment(%q) = %v, want no environment", "unregistered", found)
+	}
+
+	urls := []string{"https://eu.catalogue.test"}
+	RegisterEnvironment(Environment{Name: "register-test", IAMBaseURL: "https://iam.test", CatalogueBaseURLs: urls})
+	RegisterEnvironment(Environment{Name: "Register-Test", IAMBaseURL: "https://iam2.test", CatalogueBaseURLs: urls})
+	urls[0] = "https://modified.test"
+
+	found, ok := LookupEnvironment("REGISTER-TEST")
+	if !ok || found.IAMBaseURL != "https://iam2.test" || found.CatalogueBaseURLs[0] != "https://eu.catalogue.test" {
+		t.Errorf("LookupEnvironment() = %+v, %v; want the second registration, unaffected by the caller's slice", found, ok)
+	}
+	if names := environmentNames(); slices.Index(names, "Register-Test") < 0 || slices.Index(names, "register-test") >= 0 {
+		t.Errorf("environment names = %v, want one register-test entry", names)
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"net/http"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+)
+
+// DefaultRetryPolicy returns the policy used when none is configured.
+// Rate limited requests (429) are always retried; transport errors and server errors (5xx)
+// are only retried for idempotent methods or requests carrying an Idempotency-Key header.
+func DefaultRetryPolicy() RetryPolicy {
+	return httpclient.DefaultRetryPolicy()
+}
+
+// IsIdempotent reports whether req can safely be sent more than once
+func IsIdempotent(req *http.Request) bool {
+	return httpclient.IsIdempotent(req)
+}
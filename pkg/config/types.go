@@ -1,17 +1,70 @@
 package config
 
-import "time"
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
 
 // Option is a function that modifies a Config
 type Option func(*Config)
 
 // Config holds configuration for the Nollywood SDK
 type Config struct {
-	IAMBaseURL       string        // Base URL for the IAM service
-	CatalogueBaseURL string        // Base URL for the Catalogue service
-	ApiKey           string        // API key for authentication
-	Timeout          time.Duration // Request timeout duration
-	RetryDelay       time.Duration // Delay between retries
-	MaxRetries       int           // Maximum number of retries for requests
-	UserAgent        string        // User-Agent header value
+	IAMBaseURL            string            // Base URL for the IAM service; only required to log in with ApiKey
+	CatalogueBaseURL      string            // Base URL for the Catalogue service
+	CatalogueFallbackURLs []string          // Catalogue endpoints tried in order when CatalogueBaseURL fails with a connection error or 5xx
+	ApiKey                string            // API key for authentication
+	TokenSource           auth.TokenSource  // Supplies access tokens instead of logging in with ApiKey
+	TokenStore            auth.TokenStore   // Persists tokens across restarts; loaded on startup, saved after login and refresh
+	Timeout               time.Duration     // Request timeout duration
+	RetryDelay            time.Duration     // Delay between retries
+	MaxRetries            int               // Maximum number of retries for requests
+	MaxRetryAfter         time.Duration     // Longest server Retry-After to wait for; longer requests are given up with ErrRetryAfterTooLong
+	UserAgent             string            // User-Agent header value
+	Backoff               BackoffStrategy   // Delay strategy between retries; defaults to linear RetryDelay * attempt
+	RetryPolicy           RetryPolicy       // Decides which failed attempts are retried; defaults to DefaultRetryPolicy
+	Middleware            []Middleware      // Middleware applied to every HTTP attempt, outermost first
+	HTTPClient            *http.Client      // Base HTTP client (proxies, TLS, connection pooling); copied, never modified
+	Transport             http.RoundTripper // Transport used to send requests; overrides HTTPClient's transport
+	Logger                *slog.Logger      // Logger for requests, retries and auth events; credentials are always redacted
+	Tracer                Tracer            // Tracer for service calls, HTTP attempts and token acquisition
+	Metrics               Metrics           // Receives request latency, retry, token refresh and in-flight measurements
+
+	TokenRefreshWindow     time.Duration // Renew the access token this long before it expires
+	ClockSkew              time.Duration // Tolerated clock difference with the IAM service
+	BackgroundTokenRefresh bool          // Renew the access token in a background goroutine; stop it with Client.Close
 }
+
+// BackoffStrategy computes how long to wait before a retry attempt.
+// A Retry-After header sent by the server takes precedence over the strategy.
+type BackoffStrategy = httpclient.BackoffStrategy
+
+// BackoffFunc adapts an ordinary function to the BackoffStrategy interface
+type BackoffFunc = httpclient.BackoffFunc
+
+// RetryPolicy decides whether a failed attempt should be retried
+type RetryPolicy = httpclient.RetryPolicy
+
+// RetryPolicyFunc adapts an ordinary function to the RetryPolicy interface
+type RetryPolicyFunc = httpclient.RetryPolicyFunc
+
+// RoundTripFunc sends a single HTTP attempt and returns its response
+type RoundTripFunc = httpclient.RoundTripFunc
+
+// Middleware wraps the sending of every HTTP attempt, including retries and IAM token calls.
+// It may mutate the request, observe the response, or short-circuit without calling next.
+type Middleware = httpclient.Middleware
+
+// Tracer starts spans for SDK operations; it mirrors an OpenTelemetry tracer so adapters stay small
+type Tracer = httpclient.Tracer
+
+// Span is a single traced operation
+type Span = httpclient.Span
+
+// Metrics receives request latency, retry, token refresh and in-flight measurements.
+// See the metrics package for a Prometheus text format implementation.
+type Metrics = httpclient.Metrics
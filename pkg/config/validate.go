@@ -0,0 +1,71 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Validate checks the configuration and reports every problem found, joined into one error
+func (c *Config) Validate() error {
+	var errs []error
+
+	// IAM is only needed to log in with an API key; clients given a TokenSource or TokenStore
+	// can call the catalogue alone, skipping token refresh and revocation
+	if (c.ApiKey != "" && c.TokenSource == nil) || c.IAMBaseURL != "" {
+		errs = append(errs, validateBaseURL("IAMBaseURL", c.IAMBaseURL))
+	}
+	errs = append(errs, validateBaseURL("CatalogueBaseURL", c.CatalogueBaseURL))
+	for i, fallback := range c.CatalogueFallbackURLs {
+		errs = append(errs, validateBaseURL(fmt.Sprintf("CatalogueFallbackURLs[%d]", i), fallback))
+	}
+
+	if c.ApiKey == "" && c.TokenSource == nil && c.TokenStore == nil {
+		errs = append(errs, fmt.Errorf("no credentials: set ApiKey, TokenSource or TokenStore"))
+	}
+
+	for _, d := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"Timeout", c.Timeout},
+		{"RetryDelay", c.RetryDelay},
+		{"MaxRetryAfter", c.MaxRetryAfter},
+		{"TokenRefreshWindow", c.TokenRefreshWindow},
+		{"ClockSkew", c.ClockSkew},
+	} {
+		if d.value < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative, got %v", d.name, d.value))
+		}
+	}
+
+	if c.MaxRetries < 0 {
+		errs = append(errs, fmt.Errorf("MaxRetries must not be negative, got %d", c.MaxRetries))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateBaseURL checks that value is an absolute http or https URL
+func validateBaseURL(name, value string) error {
+	if value == "" {
+		return fmt.Errorf("%s is required", name)
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid URL: %w", name, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("%s must use http or https, got %q", name, value)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("%s has no host: %q", name, value)
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("%s must not have a query or fragment: %q", name, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	valid := func(options ...Option) *Config {
+		base := []Option{
+			WithIAMBaseURL("https://iam.test"),
+			WithCatalogueBaseURL("https://catalogue.test/v1"),
+			WithApiKey("sk_test"),
+		}
+		return NewConfig(append(base, options...)...)
+	}
+
+	tests := []struct {
+		name     string
+		config   *Config
+		wantErrs []string
+	}{
+		{name: "defaults with base URLs and key", config: valid()},
+		{name: "token source instead of key", config: valid(WithApiKey(""), WithTokenSource(auth.StaticTokenSource("t")))},
+		{
+			name:   "token source without IAM",
+			config: valid(WithApiKey(""), WithIAMBaseURL(""), WithTokenSource(auth.StaticTokenSource("t"))),
+		},
+		{
+			name:     "API key without IAM",
+			config:   valid(WithIAMBaseURL("")),
+			wantErrs: []string{"IAMBaseURL is required"},
+		},
+		{
+			name:     "empty config",
+			config:   &Config{},
+			wantErrs: []string{"CatalogueBaseURL is required", "no credentials"},
+		},
+		{
+			name:     "malformed base URLs",
+			config:   valid(WithIAMBaseURL("iam.test"), WithCatalogueBaseURL("https://catalogue.test?v=1")),
+			wantErrs: []string{"IAMBaseURL must use http or https", "CatalogueBaseURL must not have a query"},
+		},
+		{
+			name:     "negative values",
+			config:   valid(WithTimeout(-time.Second), WithMaxRetries(-1), WithClockSkew(-time.Second)),
+			wantErrs: []string{"Timeout must not be negative", "MaxRetries must not be negative", "ClockSkew must not be negative"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not mention %q", err, want)
+				}
+			}
+			if got := len(strings.Split(err.Error(), "\n")); got != len(tt.wantErrs) {
+				t.Errorf("got %d problems, want %d: %v", got, len(tt.wantErrs), err)
+			}
+		})
+	}
+}
+
+func TestNewConfig_LayersOptionsOverDefaults(t *testing.T) {
+	c := NewConfig(WithApiKey("sk_test"), WithMaxRetries(0))
+	defaults := DefaultConfig("", "")
+
+	if c.Timeout != defaults.Timeout || c.UserAgent != defaults.UserAgent || c.RetryDelay != defaults.RetryDelay {
+		t.Errorf("config = %+v, want default Timeout, UserAgent and RetryDelay", c)
+	}
+	if c.MaxRetries != 0 || c.ApiKey != "sk_test" {
+		t.Errorf("MaxRetries, ApiKey = %d, %q; want the option values", c.MaxRetries, c.ApiKey)
+	}
+}
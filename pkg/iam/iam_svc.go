@@ -0,0 +1,148 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+)
+
+// NewIAMService creates a new IAMService instance
+func NewIAMService(httpClient httpclient.Client) IAMService {
+	return &IAMSvc{
+		httpClient: httpClient,
+	}
+}
+
+// CurrentUser retrieves the profile of the authenticated user
+func (s *IAMSvc) CurrentUser(ctx context.Context) (_ *User, err error) {
+	ctx, span := s.httpClient.StartSpan(ctx, "IAMService.CurrentUser")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	urlStr := fmt.Sprintf("%s/users/me", s.httpClient.GetIAMBaseURL())
+	var user User
+
+	err = s.httpClient.Get(httpclient.WithEndpoint(ctx, "/users/me"), urlStr, nil, &user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	return &user, nil
+}
+
+// ListAPIKeys retrieves the API keys of the authenticated user
+func (s *IAMSvc) ListAPIKeys(ctx context.Context) (_ []*APIKey, err error) {
+	ctx, span := s.httpClient.StartSpan(ctx, "IAMService.ListAPIKeys")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	urlStr := fmt.Sprintf("%s/api-keys", s.httpClient.GetIAMBaseURL())
+	var keys []*APIKey
+
+	err = s.httpClient.Get(httpclient.WithEndpoint(ctx, "/api-keys"), urlStr, nil, &keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey creates an API key. The secret is only returned by this call.
+func (s *IAMSvc) CreateAPIKey(ctx context.Context, request CreateAPIKeyRequest) (_ *CreatedAPIKey, err error) {
+	ctx, span := s.httpClient.StartSpan(ctx, "IAMService.CreateAPIKey")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if request.Name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+
+	urlStr := fmt.Sprintf("%s/api-keys", s.httpClient.GetIAMBaseURL())
+	var key CreatedAPIKey
+
+	err = s.httpClient.Post(httpclient.WithEndpoint(ctx, "/api-keys"), urlStr, request, &key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	return &key, nil
+}
+
+// RotateAPIKey replaces the secret of an API key, invalidating the old one.
+// A client authenticated with the rotated key must be recreated with the new secret.
+func (s *IAMSvc) RotateAPIKey(ctx context.Context, keyID string) (_ *CreatedAPIKey, err error) {
+	ctx, span := s.httpClient.StartSpan(ctx, "IAMService.RotateAPIKey")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if keyID == "" {
+		return nil, fmt.Errorf("keyID cannot be empty")
+	}
+
+	urlStr := fmt.Sprintf("%s/api-keys/%s/rotate", s.httpClient.GetIAMBaseURL(), url.PathEscape(keyID))
+	var key CreatedAPIKey
+
+	err = s.httpClient.Post(httpclient.WithEndpoint(ctx, "/api-keys/{id}/rotate"), urlStr, nil, &key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	return &key, nil
+}
+
+// RevokeAPIKey permanently disables an API key
+func (s *IAMSvc) RevokeAPIKey(ctx context.Context, keyID string) (err error) {
+	ctx, span := s.httpClient.StartSpan(ctx, "IAMService.RevokeAPIKey")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if keyID == "" {
+		return fmt.Errorf("keyID cannot be empty")
+	}
+
+	urlStr := fmt.Sprintf("%s/api-keys/%s", s.httpClient.GetIAMBaseURL(), url.PathEscape(keyID))
+
+	err = s.httpClient.Delete(httpclient.WithEndpoint(ctx, "/api-keys/{id}"), urlStr, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	return nil
+}
+
+// ListAPIKeyRoles retrieves the roles attached to an API key
+func (s *IAMSvc) ListAPIKeyRoles(ctx context.Context, keyID string) (_ []*Role, err error) {
+	ctx, span := s.httpClient.StartSpan(ctx, "IAMService.ListAPIKeyRoles")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if keyID == "" {
+		return nil, fmt.Errorf("keyID cannot be empty")
+	}
+
+	urlStr := fmt.Sprintf("%s/api-keys/%s/roles", s.httpClient.GetIAMBaseURL(), url.PathEscape(keyID))
+	var roles []*Role
+
+	err = s.httpClient.Get(httpclient.WithEndpoint(ctx, "/api-keys/{id}/roles"), urlStr, nil, &roles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API key roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// ListAPIKeyPermissions retrieves the effective permissions of an API key
+func (s *IAMSvc) ListAPIKeyPermissions(ctx context.Context, keyID string) (_ []*Permission, err error) {
+	ctx, span := s.httpClient.StartSpan(ctx, "IAMService.ListAPIKeyPermissions")
+	defer func() { httpclient.EndSpan(span, err) }()
+
+	if keyID == "" {
+		return nil, fmt.Errorf("keyID cannot be empty")
+	}
+
+	urlStr := fmt.Sprintf("%s/api-keys/%s/permissions", s.httpClient.GetIAMBaseURL(), url.PathEscape(keyID))
+	var permissions []*Permission
+
+	err = s.httpClient.Get(httpclient.WithEndpoint(ctx, "/api-keys/{id}/permissions"), urlStr, nil, &permissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API key permissions: %w", err)
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,115 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+	"github.com/NOLLYWOOD-COM/go-sdk/pkg/auth"
+)
+
+func newTestService(t *testing.T, handler http.HandlerFunc) IAMService {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewIAMService(httpclient.New(&httpclient.Config{
+		IAMBaseURL:  server.URL,
+		TokenSource: auth.StaticTokenSource("test-token"),
+		Timeout:     time.Second,
+	}))
+}
+
+func TestIAMSvc_APIKeys(t *testing.T) {
+	var requests []string
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api-keys":
+			var body CreateAPIKeyRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if r.Header.Get(httpclient.IdempotencyKeyHeader) == "" {
+				t.Error("create request sent without an idempotency key")
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "key-1", "name": body.Name, "scopes": body.Scopes, "key": "sk_new"})
+		case "POST /api-keys/key 1/rotate":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "key 1", "key": "sk_rotated"})
+		case "GET /api-keys/key-1/permissions":
+			_, _ = w.Write([]byte(`[{"name":"works:write","resource":"works","action":"write"}]`))
+		case "DELETE /api-keys/key-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+
+	created, err := svc.CreateAPIKey(ctx, CreateAPIKeyRequest{Name: "ci", Scopes: []string{"works:read"}})
+	if err != nil {
+		t.Fatalf("CreateAPIKey() error: %v", err)
+	}
+	if created.ID != "key-1" || created.Name != "ci" || created.Key != "sk_new" || len(created.Scopes) != 1 {
+		t.Errorf("CreateAPIKey() = %+v", created)
+	}
+
+	rotated, err := svc.RotateAPIKey(ctx, "key 1")
+	if err != nil {
+		t.Fatalf("RotateAPIKey() error: %v", err)
+	}
+	if rotated.Key != "sk_rotated" {
+		t.Errorf("RotateAPIKey() key = %q, want sk_rotated", rotated.Key)
+	}
+
+	permissions, err := svc.ListAPIKeyPermissions(ctx, "key-1")
+	if err != nil {
+		t.Fatalf("ListAPIKeyPermissions() error: %v", err)
+	}
+	if len(permissions) != 1 || permissions[0].Name != "works:write" || permissions[0].Action != "write" {
+		t.Errorf("ListAPIKeyPermissions() = %+v", permissions)
+	}
+
+	if err := svc.RevokeAPIKey(ctx, "key-1"); err != nil {
+		t.Fatalf("RevokeAPIKey() error: %v", err)
+	}
+
+	want := []string{"POST /api-keys", "POST /api-keys/key%201/rotate", "GET /api-keys/key-1/permissions", "DELETE /api-keys/key-1"}
+	if len(requests) != len(want) {
+		t.Fatalf("requests = %v, want %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, requests[i], want[i])
+		}
+	}
+}
+
+func TestIAMSvc_ValidatesArguments(t *testing.T) {
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	ctx := context.Background()
+
+	if _, err := svc.CreateAPIKey(ctx, CreateAPIKeyRequest{}); err == nil {
+		t.Error("CreateAPIKey() without a name succeeded")
+	}
+	if _, err := svc.RotateAPIKey(ctx, ""); err == nil {
+		t.Error("RotateAPIKey() without a key ID succeeded")
+	}
+	if err := svc.RevokeAPIKey(ctx, ""); err == nil {
+		t.Error("RevokeAPIKey() without a key ID succeeded")
+	}
+	if _, err := svc.ListAPIKeyRoles(ctx, ""); err == nil {
+		t.Error("ListAPIKeyRoles() without a key ID succeeded")
+	}
+}
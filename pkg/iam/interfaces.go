@@ -0,0 +1,20 @@
+package iam
+
+import "context"
+
+type IAMService interface {
+	// CurrentUser retrieves the profile of the authenticated user
+	CurrentUser(ctx context.Context) (*User, error)
+	// ListAPIKeys retrieves the API keys of the authenticated user
+	ListAPIKeys(ctx context.Context) ([]*APIKey, error)
+	// CreateAPIKey creates an API key; the secret is only returned by this call
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequest) (*CreatedAPIKey, error)
+	// RotateAPIKey replaces the secret of an API key, invalidating the old one
+	RotateAPIKey(ctx context.Context, keyID string) (*CreatedAPIKey, error)
+	// RevokeAPIKey permanently disables an API key
+	RevokeAPIKey(ctx context.Context, keyID string) error
+	// ListAPIKeyRoles retrieves the roles attached to an API key
+	ListAPIKeyRoles(ctx context.Context, keyID string) ([]*Role, error)
+	// ListAPIKeyPermissions retrieves the effective permissions of an API key
+	ListAPIKeyPermissions(ctx context.Context, keyID string) ([]*Permission, error)
+}
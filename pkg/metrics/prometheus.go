@@ -0,0 +1,288 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NOLLYWOOD-COM/go-sdk/internal/httpclient"
+)
+
+// DefaultBuckets are the request duration histogram buckets, in seconds
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Prometheus collects client metrics in memory and exposes them in the Prometheus
+// text exposition format, without depending on the Prometheus client library.
+// Pass it to config.WithMetrics and serve it from a /metrics handler.
+type Prometheus struct {
+	namespace string
+	buckets   []float64
+
+	mu           sync.Mutex
+	requests     map[requestKey]*histogram
+	retries      map[retryKey]uint64
+	tokenRefresh map[tokenKey]uint64
+	inFlight     int64
+}
+
+type requestKey struct {
+	method   string
+	endpoint string
+	status   string
+}
+
+type retryKey struct {
+	method   string
+	endpoint string
+}
+
+type tokenKey struct {
+	authMethod string
+	result     string
+}
+
+type histogram struct {
+	counts []uint64 // cumulative count per bucket
+	sum    float64
+	count  uint64
+}
+
+var _ httpclient.Metrics = (*Prometheus)(nil)
+
+// NewPrometheus creates a Prometheus collector. Metric names are prefixed with namespace
+// (defaults to "nollywood_sdk"); buckets default to DefaultBuckets.
+func NewPrometheus(namespace string, buckets ...float64) *Prometheus {
+	if namespace == "" {
+		namespace = "nollywood_sdk"
+	}
+
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	return &Prometheus{
+		namespace:    namespace,
+		buckets:      sorted,
+		requests:     make(map[requestKey]*histogram),
+		retries:      make(map[retryKey]uint64),
+		tokenRefresh: make(map[tokenKey]uint64),
+	}
+}
+
+// ObserveRequest implements httpclient.Metrics
+func (p *Prometheus) ObserveRequest(method, endpoint string, statusCode int, duration time.Duration) {
+	status := "error"
+	if statusCode > 0 {
+		status = strconv.Itoa(statusCode)
+	}
+
+	seconds := duration.Seconds()
+	key := requestKey{method: method, endpoint: endpoint, status: status}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	h, ok := p.requests[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.requests[key] = h
+	}
+
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// IncRetry implements httpclient.Metrics
+func (p *Prometheus) IncRetry(method, endpoint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.retries[retryKey{method: method, endpoint: endpoint}]++
+}
+
+// IncTokenRefresh implements httpclient.Metrics
+func (p *Prometheus) IncTokenRefresh(authMethod string, success bool) {
+	result := "failure"
+	if success {
+		result = "success"
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tokenRefresh[tokenKey{authMethod: authMethod, result: result}]++
+}
+
+// AddInFlight implements httpclient.Metrics
+func (p *Prometheus) AddInFlight(delta int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inFlight += int64(delta)
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format
+func (p *Prometheus) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+
+	p.mu.Lock()
+	p.writeRequests(cw)
+	p.writeRetries(cw)
+	p.writeTokenRefreshes(cw)
+	p.writeInFlight(cw)
+	p.mu.Unlock()
+
+	if cw.err != nil {
+		return cw.n, cw.err
+	}
+	return cw.n, cw.w.Flush()
+}
+
+// ServeHTTP serves the metrics in the Prometheus text exposition format
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = p.WriteTo(w)
+}
+
+func (p *Prometheus) writeRequests(w *countingWriter) {
+	name := p.namespace + "_request_duration_seconds"
+	w.printf("# HELP %s Duration of HTTP requests made by the SDK, per attempt.\n", name)
+	w.printf("# TYPE %s histogram\n", name)
+
+	keys := make([]requestKey, 0, len(p.requests))
+	for k := range p.requests {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.endpoint != b.endpoint {
+			return a.endpoint < b.endpoint
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.status < b.status
+	})
+
+	for _, k := range keys {
+		h := p.requests[k]
+		labels := formatLabels("method", k.method, "endpoint", k.endpoint, "status", k.status)
+
+		for i, bound := range p.buckets {
+			w.printf("%s_bucket%s %d\n", name, withLabel(labels, "le", formatFloat(bound)), h.counts[i])
+		}
+		w.printf("%s_bucket%s %d\n", name, withLabel(labels, "le", "+Inf"), h.count)
+		w.printf("%s_sum%s %s\n", name, labels, formatFloat(h.sum))
+		w.printf("%s_count%s %d\n", name, labels, h.count)
+	}
+}
+
+func (p *Prometheus) writeRetries(w *countingWriter) {
+	name := p.namespace + "_retries_total"
+	w.printf("# HELP %s Number of retried HTTP requests.\n", name)
+	w.printf("# TYPE %s counter\n", name)
+
+	keys := make([]retryKey, 0, len(p.retries))
+	for k := range p.retries {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].endpoint != keys[j].endpoint {
+			return keys[i].endpoint < keys[j].endpoint
+		}
+		return keys[i].method < keys[j].method
+	})
+
+	for _, k := range keys {
+		w.printf("%s%s %d\n", name, formatLabels("method", k.method, "endpoint", k.endpoint), p.retries[k])
+	}
+}
+
+func (p *Prometheus) writeTokenRefreshes(w *countingWriter) {
+	name := p.namespace + "_token_refreshes_total"
+	w.printf("# HELP %s Number of access token acquisitions by method and result.\n", name)
+	w.printf("# TYPE %s counter\n", name)
+
+	keys := make([]tokenKey, 0, len(p.tokenRefresh))
+	for k := range p.tokenRefresh {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].authMethod != keys[j].authMethod {
+			return keys[i].authMethod < keys[j].authMethod
+		}
+		return keys[i].result < keys[j].result
+	})
+
+	for _, k := range keys {
+		w.printf("%s%s %d\n", name, formatLabels("method", k.authMethod, "result", k.result), p.tokenRefresh[k])
+	}
+}
+
+func (p *Prometheus) writeInFlight(w *countingWriter) {
+	name := p.namespace + "_in_flight_requests"
+	w.printf("# HELP %s Number of HTTP requests currently in flight.\n", name)
+	w.printf("# TYPE %s gauge\n", name)
+	w.printf("%s %d\n", name, p.inFlight)
+}
+
+// formatLabels renders label pairs as {k1="v1",k2="v2"}
+func formatLabels(pairs ...string) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		fmt.Fprintf(&sb, "%s=\"%s\"", pairs[i], escapeLabelValue(pairs[i+1]))
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// withLabel appends a label to an already formatted label set
+func withLabel(labels, key, value string) string {
+	return strings.TrimSuffix(labels, "}") + fmt.Sprintf(",%s=\"%s\"}", key, escapeLabelValue(value))
+}
+
+// labelEscaper escapes label values as required by the text exposition format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue escapes a label value for the text exposition format
+func escapeLabelValue(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+// formatFloat renders a float the way Prometheus expects
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// countingWriter tracks bytes written and the first error
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) printf(format string, args ...any) {
+	if cw.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(cw.w, format, args...)
+	cw.n += int64(n)
+	cw.err = err
+}
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrometheus_WriteTo(t *testing.T) {
+	p := NewPrometheus("", 0.1, 1)
+
+	p.ObserveRequest("GET", "/works/{identifier}", 200, 50*time.Millisecond)
+	p.ObserveRequest("GET", "/works/{identifier}", 200, 500*time.Millisecond)
+	p.ObserveRequest("GET", "/works/{identifier}", 0, 2*time.Second)
+	p.IncRetry("GET", "/works/{identifier}")
+	p.IncTokenRefresh("api_key", true)
+	p.IncTokenRefresh("refresh", false)
+	p.AddInFlight(2)
+	p.AddInFlight(-1)
+
+	var sb strings.Builder
+	n, err := p.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if int(n) != sb.Len() {
+		t.Errorf("WriteTo() = %d bytes, wrote %d", n, sb.Len())
+	}
+
+	out := sb.String()
+	want := []string{
+		`# TYPE nollywood_sdk_request_duration_seconds histogram`,
+		`nollywood_sdk_request_duration_seconds_bucket{method="GET",endpoint="/works/{identifier}",status="200",le="0.1"} 1`,
+		`nollywood_sdk_request_duration_seconds_bucket{method="GET",endpoint="/works/{identifier}",status="200",le="1"} 2`,
+		`nollywood_sdk_request_duration_seconds_bucket{method="GET",endpoint="/works/{identifier}",status="200",le="+Inf"} 2`,
+		`nollywood_sdk_request_duration_seconds_sum{method="GET",endpoint="/works/{identifier}",status="200"} 0.55`,
+		`nollywood_sdk_request_duration_seconds_count{method="GET",endpoint="/works/{identifier}",status="error"} 1`,
+		`nollywood_sdk_retries_total{method="GET",endpoint="/works/{identifier}"} 1`,
+		`nollywood_sdk_token_refreshes_total{method="api_key",result="success"} 1`,
+		`nollywood_sdk_token_refreshes_total{method="refresh",result="failure"} 1`,
+		`# TYPE nollywood_sdk_in_flight_requests gauge`,
+		`nollywood_sdk_in_flight_requests 1`,
+	}
+	for _, line := range want {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("output does not contain %q:\n%s", line, out)
+		}
+	}
+}
+
+func TestPrometheus_EscapesLabels(t *testing.T) {
+	p := NewPrometheus("test")
+	p.IncRetry("GET", "/odd\"path\\\n")
+
+	var sb strings.Builder
+	if _, err := p.WriteTo(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `test_retries_total{method="GET",endpoint="/odd\"path\\\n"} 1`
+	if !strings.Contains(sb.String(), want) {
+		t.Errorf("output does not contain %q:\n%s", want, sb.String())
+	}
+}
+
+func TestPrometheus_ServeHTTP(t *testing.T) {
+	p := NewPrometheus("")
+	p.AddInFlight(3)
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Content-Type = %q, want text/plain", ct)
+	}
+	if !strings.Contains(rec.Body.String(), "nollywood_sdk_in_flight_requests 3\n") {
+		t.Errorf("body does not contain in-flight gauge:\n%s", rec.Body.String())
+	}
+}